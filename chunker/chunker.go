@@ -0,0 +1,71 @@
+// Package chunker splits loaded document text into chunks sized for
+// embedding and retrieval, carrying enough metadata to trace each chunk
+// back to its source document and location.
+package chunker
+
+// Chunk is a single piece of text produced by a Splitter, along with the
+// metadata needed to cite and re-assemble it.
+type Chunk struct {
+	// Text is the chunk's content.
+	Text string
+	// ParentDocID identifies the document this chunk was split from.
+	ParentDocID string
+	// StartOffset and EndOffset are byte offsets into the parent
+	// document's text that this chunk spans.
+	StartOffset int
+	EndOffset   int
+	// SectionPath is the heading hierarchy leading to this chunk, e.g.
+	// ["Introduction", "Background"], populated by structure-aware
+	// splitters. It is nil for splitters that aren't structure-aware.
+	SectionPath []string
+}
+
+// Splitter splits a document's text into Chunks.
+type Splitter interface {
+	// Split breaks text (belonging to parentDocID) into chunks.
+	Split(parentDocID, text string) ([]Chunk, error)
+}
+
+// Strategy identifies one of the built-in splitter implementations.
+type Strategy string
+
+const (
+	// StrategyFixedSize splits on a fixed character count with overlap.
+	StrategyFixedSize Strategy = "fixed-size"
+	// StrategyRecursive splits on a descending list of separators
+	// (paragraph, line, sentence, word), falling back to fixed-size.
+	StrategyRecursive Strategy = "recursive"
+	// StrategyToken splits on a token budget using a real tokenizer.
+	StrategyToken Strategy = "token"
+	// StrategyMarkdown splits on markdown/HTML headings, keeping each
+	// section's heading path as chunk metadata.
+	StrategyMarkdown Strategy = "markdown"
+)
+
+// New returns the Splitter for the given strategy, configured with
+// chunkSize and overlap (both interpreted in characters, except for
+// StrategyToken where they are interpreted in tokens). It returns an
+// error for an unrecognized strategy.
+func New(strategy Strategy, chunkSize, overlap int) (Splitter, error) {
+	switch strategy {
+	case StrategyFixedSize:
+		return NewFixedSizeSplitter(chunkSize, overlap), nil
+	case StrategyRecursive:
+		return NewRecursiveSplitter(chunkSize, overlap), nil
+	case StrategyToken:
+		return NewTokenSplitter(chunkSize, overlap)
+	case StrategyMarkdown:
+		return NewMarkdownSplitter(chunkSize, overlap), nil
+	default:
+		return nil, &UnknownStrategyError{Strategy: strategy}
+	}
+}
+
+// UnknownStrategyError is returned by New for an unrecognized Strategy.
+type UnknownStrategyError struct {
+	Strategy Strategy
+}
+
+func (e *UnknownStrategyError) Error() string {
+	return "chunker: unknown strategy " + string(e.Strategy)
+}
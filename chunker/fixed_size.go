@@ -0,0 +1,61 @@
+package chunker
+
+// FixedSizeSplitter splits text into chunks of a fixed character length,
+// with a configurable overlap between consecutive chunks.
+type FixedSizeSplitter struct {
+	chunkSize int
+	overlap   int
+}
+
+// NewFixedSizeSplitter returns a Splitter that produces chunks of
+// chunkSize characters, each overlapping the previous chunk by overlap
+// characters. If overlap is negative or >= chunkSize, it is clamped to
+// chunkSize/2.
+func NewFixedSizeSplitter(chunkSize, overlap int) *FixedSizeSplitter {
+	if chunkSize <= 0 {
+		chunkSize = 1000
+	}
+	if overlap < 0 || overlap >= chunkSize {
+		overlap = chunkSize / 2
+	}
+	return &FixedSizeSplitter{chunkSize: chunkSize, overlap: overlap}
+}
+
+// Split implements Splitter.
+func (s *FixedSizeSplitter) Split(parentDocID, text string) ([]Chunk, error) {
+	runes := []rune(text)
+	if len(runes) == 0 {
+		return nil, nil
+	}
+
+	// byteOffsets[i] is the byte offset of runes[i] in text, with a final
+	// entry for len(text), so chunk offsets are byte offsets as Chunk
+	// documents rather than rune indices.
+	byteOffsets := make([]int, 0, len(runes)+1)
+	for i := range text {
+		byteOffsets = append(byteOffsets, i)
+	}
+	byteOffsets = append(byteOffsets, len(text))
+
+	step := s.chunkSize - s.overlap
+	var chunks []Chunk
+	for start := 0; start < len(runes); start += step {
+		end := start + s.chunkSize
+		if end > len(runes) {
+			end = len(runes)
+		}
+
+		chunks = append(chunks, Chunk{
+			Text:        string(runes[start:end]),
+			ParentDocID: parentDocID,
+			StartOffset: byteOffsets[start],
+			EndOffset:   byteOffsets[end],
+		})
+
+		if end == len(runes) {
+			break
+		}
+	}
+
+	return chunks, nil
+}
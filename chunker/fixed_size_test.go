@@ -0,0 +1,44 @@
+package chunker
+
+import "testing"
+
+func TestFixedSizeSplitter_Split(t *testing.T) {
+	text := "0123456789abcdefghij" // 20 chars
+	s := NewFixedSizeSplitter(10, 2)
+
+	chunks, err := s.Split("doc-1", text)
+	if err != nil {
+		t.Fatalf("Split: %v", err)
+	}
+
+	want := []string{"0123456789", "89abcdefgh", "ghij"}
+	if len(chunks) != len(want) {
+		t.Fatalf("got %d chunks, want %d: %#v", len(chunks), len(want), chunks)
+	}
+	for i, c := range chunks {
+		if c.Text != want[i] {
+			t.Errorf("chunk %d = %q, want %q", i, c.Text, want[i])
+		}
+		if c.ParentDocID != "doc-1" {
+			t.Errorf("chunk %d ParentDocID = %q, want %q", i, c.ParentDocID, "doc-1")
+		}
+	}
+}
+
+func TestFixedSizeSplitter_EmptyText(t *testing.T) {
+	s := NewFixedSizeSplitter(10, 2)
+	chunks, err := s.Split("doc-1", "")
+	if err != nil {
+		t.Fatalf("Split: %v", err)
+	}
+	if len(chunks) != 0 {
+		t.Fatalf("got %d chunks for empty text, want 0", len(chunks))
+	}
+}
+
+func TestFixedSizeSplitter_OverlapClampedWhenInvalid(t *testing.T) {
+	s := NewFixedSizeSplitter(10, 10) // overlap >= chunkSize, should clamp
+	if s.overlap != 5 {
+		t.Fatalf("overlap = %d, want 5", s.overlap)
+	}
+}
@@ -0,0 +1,154 @@
+package chunker
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MarkdownSplitter splits text on markdown/HTML headings (lines
+// starting with "#", or a heading level tracked while scanning), keeping
+// each resulting section under roughly chunkSize characters and
+// recording the heading path in Chunk.SectionPath. Oversized sections
+// fall back to a RecursiveSplitter.
+type MarkdownSplitter struct {
+	chunkSize int
+	overlap   int
+}
+
+// NewMarkdownSplitter returns a structure-aware Splitter for
+// markdown/HTML documents.
+func NewMarkdownSplitter(chunkSize, overlap int) *MarkdownSplitter {
+	if chunkSize <= 0 {
+		chunkSize = 1000
+	}
+	return &MarkdownSplitter{chunkSize: chunkSize, overlap: overlap}
+}
+
+// section is one heading-delimited block of the document, with the
+// heading path ("H1 > H2 > ...") leading to it.
+type section struct {
+	path []string
+	body string
+	// start is the byte offset of body within the source text.
+	start int
+}
+
+// Split implements Splitter.
+func (s *MarkdownSplitter) Split(parentDocID, text string) ([]Chunk, error) {
+	sections := splitByHeadings(text)
+
+	fallback := NewRecursiveSplitter(s.chunkSize, s.overlap)
+
+	var chunks []Chunk
+	for _, sec := range sections {
+		offset := sec.start
+		if len(sec.body) <= s.chunkSize {
+			chunks = append(chunks, Chunk{
+				Text:        sec.body,
+				ParentDocID: parentDocID,
+				StartOffset: offset,
+				EndOffset:   offset + len(sec.body),
+				SectionPath: sec.path,
+			})
+		} else {
+			sub, err := fallback.Split(parentDocID, sec.body)
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range sub {
+				c.StartOffset += offset
+				c.EndOffset += offset
+				c.SectionPath = sec.path
+				chunks = append(chunks, c)
+			}
+		}
+	}
+
+	return chunks, nil
+}
+
+// splitByHeadings walks text line by line, grouping lines under the
+// nearest preceding "#"-style heading and tracking the heading
+// hierarchy as the current section path.
+func splitByHeadings(text string) []section {
+	var sections []section
+	var path []string
+	var body strings.Builder
+	pos, bodyStart := 0, 0
+
+	flush := func() {
+		if body.Len() == 0 {
+			return
+		}
+		sections = append(sections, section{
+			path:  append([]string(nil), path...),
+			body:  body.String(),
+			start: bodyStart,
+		})
+		body.Reset()
+	}
+
+	// SplitAfter keeps each line's trailing "\n" attached (the final
+	// line has none if text doesn't end in a newline), so body is
+	// reassembled byte-for-byte instead of gaining a newline text never had.
+	for _, line := range strings.SplitAfter(text, "\n") {
+		if line == "" {
+			continue
+		}
+		lineStart := pos
+		pos += len(line)
+		if level, title, ok := headingLine(strings.TrimSuffix(line, "\n")); ok {
+			flush()
+			if level-1 < len(path) {
+				path = path[:level-1]
+			}
+			for len(path) < level-1 {
+				path = append(path, "")
+			}
+			path = append(path, title)
+			continue
+		}
+		if body.Len() == 0 {
+			bodyStart = lineStart
+		}
+		body.WriteString(line)
+	}
+	flush()
+
+	if len(sections) == 0 {
+		return []section{{body: text}}
+	}
+	return sections
+}
+
+// headingLine reports whether line is a heading — either a markdown
+// ATX heading ("# Title") or an HTML heading ("<h1>Title</h1>") on a
+// line by itself — and, if so, its level and title text.
+func headingLine(line string) (level int, title string, ok bool) {
+	if level, title, ok := htmlHeadingLine(strings.TrimSpace(line)); ok {
+		return level, title, true
+	}
+
+	trimmed := strings.TrimLeft(line, " ")
+	n := 0
+	for n < len(trimmed) && trimmed[n] == '#' {
+		n++
+	}
+	if n == 0 || n > 6 || n >= len(trimmed) || trimmed[n] != ' ' {
+		return 0, "", false
+	}
+	return n, strings.TrimSpace(trimmed[n+1:]), true
+}
+
+// htmlHeadingLine reports whether trimmed is a single-line HTML heading
+// ("<h1>Title</h1>" through "<h6>Title</h6>") and, if so, its level and
+// title text.
+func htmlHeadingLine(trimmed string) (level int, title string, ok bool) {
+	for n := 1; n <= 6; n++ {
+		open, close := fmt.Sprintf("<h%d>", n), fmt.Sprintf("</h%d>", n)
+		if strings.HasPrefix(trimmed, open) && strings.HasSuffix(trimmed, close) {
+			return n, strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(trimmed, open), close)), true
+		}
+	}
+	return 0, "", false
+}
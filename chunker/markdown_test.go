@@ -0,0 +1,59 @@
+package chunker
+
+import "testing"
+
+func TestMarkdownSplitter_TracksHeadingPath(t *testing.T) {
+	text := "# Title\n\nIntro text.\n\n## Section A\n\nBody A.\n\n## Section B\n\nBody B.\n"
+	s := NewMarkdownSplitter(1000, 0)
+
+	chunks, err := s.Split("doc-1", text)
+	if err != nil {
+		t.Fatalf("Split: %v", err)
+	}
+	if len(chunks) != 3 {
+		t.Fatalf("got %d chunks, want 3: %#v", len(chunks), chunks)
+	}
+
+	if got := chunks[0].SectionPath; len(got) != 1 || got[0] != "Title" {
+		t.Errorf("chunk 0 SectionPath = %v, want [Title]", got)
+	}
+	if got := chunks[1].SectionPath; len(got) != 2 || got[1] != "Section A" {
+		t.Errorf("chunk 1 SectionPath = %v, want [Title Section A]", got)
+	}
+	if got := chunks[2].SectionPath; len(got) != 2 || got[1] != "Section B" {
+		t.Errorf("chunk 2 SectionPath = %v, want [Title Section B]", got)
+	}
+}
+
+func TestMarkdownSplitter_TracksHTMLHeadings(t *testing.T) {
+	text := "<h1>Title</h1>\n\nIntro text.\n\n<h2>Section A</h2>\n\nBody A.\n"
+	s := NewMarkdownSplitter(1000, 0)
+
+	chunks, err := s.Split("doc-1", text)
+	if err != nil {
+		t.Fatalf("Split: %v", err)
+	}
+	if len(chunks) != 2 {
+		t.Fatalf("got %d chunks, want 2: %#v", len(chunks), chunks)
+	}
+
+	if got := chunks[0].SectionPath; len(got) != 1 || got[0] != "Title" {
+		t.Errorf("chunk 0 SectionPath = %v, want [Title]", got)
+	}
+	if got := chunks[1].SectionPath; len(got) != 2 || got[1] != "Section A" {
+		t.Errorf("chunk 1 SectionPath = %v, want [Title Section A]", got)
+	}
+}
+
+func TestMarkdownSplitter_NoHeadingsFallsBackToWholeText(t *testing.T) {
+	text := "Just a plain paragraph with no headings at all."
+	s := NewMarkdownSplitter(1000, 0)
+
+	chunks, err := s.Split("doc-1", text)
+	if err != nil {
+		t.Fatalf("Split: %v", err)
+	}
+	if len(chunks) != 1 || chunks[0].Text != text {
+		t.Fatalf("got %#v, want single chunk with full text", chunks)
+	}
+}
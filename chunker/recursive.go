@@ -0,0 +1,120 @@
+package chunker
+
+import "strings"
+
+// recursiveSeparators are tried in order, from coarsest to finest, so
+// that splits prefer paragraph boundaries over mid-sentence breaks.
+var recursiveSeparators = []string{"\n\n", "\n", ". ", " "}
+
+// RecursiveSplitter splits text on a descending list of separators
+// (paragraph, line, sentence, word), merging pieces back together into
+// chunks of roughly chunkSize characters with overlap between them.
+// Text that still doesn't fit after the finest separator falls back to
+// a fixed-size split.
+type RecursiveSplitter struct {
+	chunkSize int
+	overlap   int
+}
+
+// NewRecursiveSplitter returns a Splitter using the standard
+// paragraph/line/sentence/word separator cascade.
+func NewRecursiveSplitter(chunkSize, overlap int) *RecursiveSplitter {
+	if chunkSize <= 0 {
+		chunkSize = 1000
+	}
+	if overlap < 0 || overlap >= chunkSize {
+		overlap = chunkSize / 5
+	}
+	return &RecursiveSplitter{chunkSize: chunkSize, overlap: overlap}
+}
+
+// Split implements Splitter.
+func (s *RecursiveSplitter) Split(parentDocID, text string) ([]Chunk, error) {
+	pieces := s.splitRecursive(text, 0)
+
+	var chunks []Chunk
+	offset := 0
+	var buf strings.Builder
+
+	flush := func() {
+		if buf.Len() == 0 {
+			return
+		}
+		t := buf.String()
+		chunks = append(chunks, Chunk{
+			Text:        t,
+			ParentDocID: parentDocID,
+			StartOffset: offset - len(t),
+			EndOffset:   offset,
+		})
+		buf.Reset()
+	}
+
+	for _, p := range pieces {
+		if buf.Len() > 0 && buf.Len()+len(p) > s.chunkSize {
+			flush()
+			// Carry the overlap tail forward into the next chunk.
+			// Slice by rune, not byte, so the cut can't land mid-
+			// codepoint on non-ASCII text.
+			if s.overlap > 0 {
+				tail := []rune(chunks[len(chunks)-1].Text)
+				if len(tail) > s.overlap {
+					tail = tail[len(tail)-s.overlap:]
+				}
+				buf.WriteString(string(tail))
+			}
+		}
+		buf.WriteString(p)
+		offset += len(p)
+	}
+	flush()
+
+	return chunks, nil
+}
+
+// splitRecursive splits text using recursiveSeparators[level], falling
+// back to fixed-size slices once separators are exhausted and a piece
+// still exceeds chunkSize.
+func (s *RecursiveSplitter) splitRecursive(text string, level int) []string {
+	if len(text) <= s.chunkSize {
+		return []string{text}
+	}
+
+	if level >= len(recursiveSeparators) {
+		return fixedSizeSlices(text, s.chunkSize)
+	}
+
+	sep := recursiveSeparators[level]
+	parts := strings.Split(text, sep)
+	if len(parts) == 1 {
+		return s.splitRecursive(text, level+1)
+	}
+
+	var out []string
+	for i, part := range parts {
+		if i < len(parts)-1 {
+			part += sep
+		}
+		if len(part) > s.chunkSize {
+			out = append(out, s.splitRecursive(part, level+1)...)
+		} else {
+			out = append(out, part)
+		}
+	}
+	return out
+}
+
+// fixedSizeSlices slices text into size-character pieces without regard
+// to word boundaries, as a last-resort fallback.
+func fixedSizeSlices(text string, size int) []string {
+	runes := []rune(text)
+	var out []string
+	for start := 0; start < len(runes); start += size {
+		end := start + size
+		if end > len(runes) {
+			end = len(runes)
+		}
+		out = append(out, string(runes[start:end]))
+	}
+	return out
+}
@@ -0,0 +1,78 @@
+package chunker
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestRecursiveSplitter_PrefersParagraphBoundaries(t *testing.T) {
+	text := "Paragraph one.\n\nParagraph two.\n\nParagraph three."
+	s := NewRecursiveSplitter(20, 0)
+
+	chunks, err := s.Split("doc-1", text)
+	if err != nil {
+		t.Fatalf("Split: %v", err)
+	}
+	if len(chunks) == 0 {
+		t.Fatal("got 0 chunks")
+	}
+
+	// Reassembling the chunk text should recover the original content.
+	var rebuilt strings.Builder
+	for _, c := range chunks {
+		rebuilt.WriteString(c.Text)
+	}
+	if rebuilt.String() != text {
+		t.Errorf("rebuilt text = %q, want %q", rebuilt.String(), text)
+	}
+}
+
+func TestRecursiveSplitter_OverlapIsRuneSafeOnNonASCIIText(t *testing.T) {
+	text := strings.Repeat("café ", 10)
+	s := NewRecursiveSplitter(20, 3)
+
+	chunks, err := s.Split("doc-1", text)
+	if err != nil {
+		t.Fatalf("Split: %v", err)
+	}
+	if len(chunks) < 2 {
+		t.Fatalf("got %d chunks, want at least 2 to exercise the overlap carry-over", len(chunks))
+	}
+
+	for i, c := range chunks {
+		if !utf8.ValidString(c.Text) {
+			t.Errorf("chunk %d is not valid UTF-8: %q", i, c.Text)
+		}
+	}
+
+	for i := 1; i < len(chunks); i++ {
+		prevRunes := []rune(chunks[i-1].Text)
+		if len(prevRunes) < 3 {
+			continue
+		}
+		wantTail := string(prevRunes[len(prevRunes)-3:])
+		if !strings.HasPrefix(chunks[i].Text, wantTail) {
+			t.Errorf("chunk %d does not start with the 3-rune overlap tail %q carried from chunk %d: got %q", i, wantTail, i-1, chunks[i].Text)
+		}
+	}
+}
+
+func TestRecursiveSplitter_FallsBackToFixedSizeForUnsplittableText(t *testing.T) {
+	// No separators at all, longer than chunkSize.
+	text := strings.Repeat("x", 25)
+	s := NewRecursiveSplitter(10, 0)
+
+	chunks, err := s.Split("doc-1", text)
+	if err != nil {
+		t.Fatalf("Split: %v", err)
+	}
+	if len(chunks) == 0 {
+		t.Fatal("got 0 chunks")
+	}
+	for _, c := range chunks {
+		if len(c.Text) > 10 {
+			t.Errorf("chunk length %d exceeds chunkSize 10: %q", len(c.Text), c.Text)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package chunker
+
+import (
+	"fmt"
+
+	"github.com/tiktoken-go/tokenizer"
+)
+
+// TokenSplitter splits text into chunks of a fixed token budget, using a
+// real tokenizer so chunk sizes line up with model context limits
+// rather than approximating via character counts.
+type TokenSplitter struct {
+	codec     tokenizer.Codec
+	chunkSize int
+	overlap   int
+}
+
+// NewTokenSplitter returns a Splitter that produces chunks of chunkSize
+// tokens (cl100k_base encoding), overlapping by overlap tokens.
+func NewTokenSplitter(chunkSize, overlap int) (*TokenSplitter, error) {
+	if chunkSize <= 0 {
+		chunkSize = 512
+	}
+	if overlap < 0 || overlap >= chunkSize {
+		overlap = chunkSize / 10
+	}
+
+	codec, err := tokenizer.Get(tokenizer.Cl100kBase)
+	if err != nil {
+		return nil, fmt.Errorf("chunker: load tokenizer: %v", err)
+	}
+
+	return &TokenSplitter{codec: codec, chunkSize: chunkSize, overlap: overlap}, nil
+}
+
+// Split implements Splitter.
+func (s *TokenSplitter) Split(parentDocID, text string) ([]Chunk, error) {
+	ids, _, err := s.codec.Encode(text)
+	if err != nil {
+		return nil, fmt.Errorf("chunker: encode text: %v", err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	step := s.chunkSize - s.overlap
+	var chunks []Chunk
+	// StartOffset/EndOffset must be byte offsets into the source text
+	// (per Chunk's doc comment), not token indices. Since decode is
+	// concatenative over a contiguous token range from a single Encode
+	// call, track the running byte offset of ids[start] incrementally
+	// instead of re-decoding the whole prefix on every iteration.
+	prevStart, prevOffset := 0, 0
+	for start := 0; start < len(ids); start += step {
+		end := start + s.chunkSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		chunkText, err := s.codec.Decode(ids[start:end])
+		if err != nil {
+			return nil, fmt.Errorf("chunker: decode chunk tokens: %v", err)
+		}
+
+		between, err := s.codec.Decode(ids[prevStart:start])
+		if err != nil {
+			return nil, fmt.Errorf("chunker: decode preceding tokens: %v", err)
+		}
+		startOffset := prevOffset + len(between)
+		prevStart, prevOffset = start, startOffset
+
+		chunks = append(chunks, Chunk{
+			Text:        chunkText,
+			ParentDocID: parentDocID,
+			StartOffset: startOffset,
+			EndOffset:   startOffset + len(chunkText),
+		})
+
+		if end == len(ids) {
+			break
+		}
+	}
+
+	return chunks, nil
+}
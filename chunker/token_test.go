@@ -0,0 +1,67 @@
+package chunker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTokenSplitter_OffsetsAreByteOffsetsIntoSourceText(t *testing.T) {
+	s, err := NewTokenSplitter(8, 2)
+	if err != nil {
+		t.Fatalf("NewTokenSplitter: %v", err)
+	}
+
+	text := "The quick brown fox jumps over the lazy dog, again and again, many times over."
+	chunks, err := s.Split("doc-1", text)
+	if err != nil {
+		t.Fatalf("Split: %v", err)
+	}
+	if len(chunks) < 2 {
+		t.Fatalf("got %d chunks, want at least 2 to exercise overlap", len(chunks))
+	}
+
+	for i, c := range chunks {
+		if c.StartOffset < 0 || c.EndOffset > len(text) || c.StartOffset > c.EndOffset {
+			t.Fatalf("chunk %d has out-of-range offsets [%d:%d] for text of length %d", i, c.StartOffset, c.EndOffset, len(text))
+		}
+		if got := text[c.StartOffset:c.EndOffset]; got != c.Text {
+			t.Errorf("chunk %d: text[%d:%d] = %q, want chunk text %q", i, c.StartOffset, c.EndOffset, got, c.Text)
+		}
+	}
+}
+
+func TestTokenSplitter_EmptyText(t *testing.T) {
+	s, err := NewTokenSplitter(8, 2)
+	if err != nil {
+		t.Fatalf("NewTokenSplitter: %v", err)
+	}
+
+	chunks, err := s.Split("doc-1", "")
+	if err != nil {
+		t.Fatalf("Split: %v", err)
+	}
+	if chunks != nil {
+		t.Errorf("got %v, want nil chunks for empty text", chunks)
+	}
+}
+
+func TestTokenSplitter_RebuildsFullText(t *testing.T) {
+	s, err := NewTokenSplitter(8, 0)
+	if err != nil {
+		t.Fatalf("NewTokenSplitter: %v", err)
+	}
+
+	text := "one two three four five six seven eight nine ten eleven twelve"
+	chunks, err := s.Split("doc-1", text)
+	if err != nil {
+		t.Fatalf("Split: %v", err)
+	}
+
+	var rebuilt strings.Builder
+	for _, c := range chunks {
+		rebuilt.WriteString(c.Text)
+	}
+	if rebuilt.String() != text {
+		t.Errorf("rebuilt text = %q, want %q", rebuilt.String(), text)
+	}
+}
@@ -0,0 +1,110 @@
+// Command ragchat is an interactive REPL that answers questions against
+// a LlamaStack vector store, streaming tokens as they arrive and
+// keeping a rolling conversation history across turns.
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	llamastackclient "github.com/llamastack/llama-stack-client-go"
+	"github.com/llamastack/llama-stack-client-go/option"
+)
+
+const (
+	candidatePool = 10
+	topN          = 3
+)
+
+func main() {
+	baseURL := flag.String("base-url", "http://localhost:8321", "LlamaStack server base URL")
+	vectorStoreID := flag.String("vector-store-id", "", "vector store to retrieve from (required)")
+	model := flag.String("model", "", "LLM to use for generation; autodetects the first available LLM if unset")
+	flag.Parse()
+
+	if *vectorStoreID == "" {
+		fmt.Fprintln(os.Stderr, "ragchat: -vector-store-id is required")
+		os.Exit(1)
+	}
+
+	client := llamastackclient.NewClient(option.WithBaseURL(*baseURL))
+
+	if err := run(&client, *vectorStoreID, *model); err != nil {
+		fmt.Fprintf(os.Stderr, "ragchat: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run drives the REPL loop against stdin/stdout.
+func run(client *llamastackclient.Client, vectorStoreID, model string) error {
+	ctx := context.Background()
+
+	if model == "" {
+		resolved, err := resolveModel(ctx, client)
+		if err != nil {
+			return err
+		}
+		model = resolved
+	}
+
+	session := newSession(client, vectorStoreID, model)
+
+	fmt.Println("=== ragchat ===")
+	fmt.Println("Type a question, or /sources, /reset, /quit.")
+
+	scanner := bufio.NewScanner(os.Stdin)
+	for {
+		fmt.Print("\n> ")
+		if !scanner.Scan() {
+			return scanner.Err()
+		}
+
+		input := strings.TrimSpace(scanner.Text())
+		if input == "" {
+			continue
+		}
+
+		switch input {
+		case "/quit", "/exit":
+			return nil
+		case "/reset":
+			session.reset()
+			fmt.Println("Conversation history cleared.")
+			continue
+		case "/sources":
+			session.printSources()
+			continue
+		}
+
+		if err := session.ask(ctx, input); err != nil {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		}
+	}
+}
+
+// resolveModel returns the identifier of the first available LLM,
+// mirroring the model-selection logic in the single-shot demo. Models.List
+// only exposes the OpenAI-compatible listing (no model_type), so each
+// candidate is looked up individually via Models.Get to find its native type.
+func resolveModel(ctx context.Context, client *llamastackclient.Client) (string, error) {
+	models, err := client.Models.List(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error fetching models: %v", err)
+	}
+
+	for _, m := range *models {
+		info, err := client.Models.Get(ctx, m.ID)
+		if err != nil {
+			return "", fmt.Errorf("error fetching model %s: %v", m.ID, err)
+		}
+		if info.ModelType == llamastackclient.ModelGetResponseModelTypeLlm {
+			return info.Identifier, nil
+		}
+	}
+
+	return "", fmt.Errorf("no LLM model available for generation")
+}
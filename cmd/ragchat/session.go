@@ -0,0 +1,209 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	llamastackclient "github.com/llamastack/llama-stack-client-go"
+	"github.com/varshaprasad96/go-rag-demo/rerank"
+)
+
+// session holds the rolling conversation state for one ragchat run: the
+// message history sent to the LLM, and the chunks retrieved for the
+// most recent turn (so /sources can display them).
+type session struct {
+	client        *llamastackclient.Client
+	vectorStoreID string
+	model         string
+
+	history     []llamastackclient.ChatCompletionNewParamsMessageUnion
+	lastSources []rerank.Result
+}
+
+func newSession(client *llamastackclient.Client, vectorStoreID, model string) *session {
+	return &session{client: client, vectorStoreID: vectorStoreID, model: model}
+}
+
+func (s *session) reset() {
+	s.history = nil
+	s.lastSources = nil
+}
+
+func (s *session) printSources() {
+	if len(s.lastSources) == 0 {
+		fmt.Println("No sources retrieved yet.")
+		return
+	}
+	for i, src := range s.lastSources {
+		fmt.Printf("\n--- Source %d (score %.4f) ---\n%s\n", i+1, src.Score, src.Content)
+	}
+}
+
+// ask retrieves context for userInput, streams a generated answer, and
+// appends both the question and answer to the conversation history.
+func (s *session) ask(ctx context.Context, userInput string) error {
+	searchQuery, err := s.rewriteQuery(ctx, userInput)
+	if err != nil {
+		return fmt.Errorf("rewriting query: %v", err)
+	}
+
+	sources, err := s.retrieve(ctx, searchQuery)
+	if err != nil {
+		return fmt.Errorf("retrieving context: %v", err)
+	}
+	s.lastSources = sources
+
+	var contextBuilder strings.Builder
+	contextBuilder.WriteString("Based on the following information:\n\n")
+	for i, src := range sources {
+		contextBuilder.WriteString(fmt.Sprintf("%d. %s\n", i+1, src.Content))
+	}
+	contextBuilder.WriteString("\nPlease answer the question: " + userInput)
+
+	s.history = append(s.history, llamastackclient.ChatCompletionNewParamsMessageUnion{
+		OfUser: &llamastackclient.ChatCompletionNewParamsMessageUser{
+			Content: llamastackclient.ChatCompletionNewParamsMessageUserContentUnion{
+				OfString: llamastackclient.String(contextBuilder.String()),
+			},
+		},
+	})
+
+	answer, err := s.streamAnswer(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.history = append(s.history, llamastackclient.ChatCompletionNewParamsMessageUnion{
+		OfAssistant: &llamastackclient.ChatCompletionNewParamsMessageAssistant{
+			Content: llamastackclient.ChatCompletionNewParamsMessageAssistantContentUnion{
+				OfString: llamastackclient.String(answer),
+			},
+		},
+	})
+
+	return nil
+}
+
+// rewriteQuery asks the LLM to rephrase the latest user message into a
+// standalone search query given the conversation so far. With no prior
+// history, the user's input is used as-is.
+func (s *session) rewriteQuery(ctx context.Context, userInput string) (string, error) {
+	if len(s.history) == 0 {
+		return userInput, nil
+	}
+
+	var historyText strings.Builder
+	for _, msg := range s.history {
+		switch {
+		case msg.OfUser != nil:
+			historyText.WriteString("User: " + msg.OfUser.Content.OfString.Value + "\n")
+		case msg.OfAssistant != nil:
+			historyText.WriteString("Assistant: " + msg.OfAssistant.Content.OfString.Value + "\n")
+		}
+	}
+
+	prompt := fmt.Sprintf(
+		"Given this conversation history:\n%s\nRewrite the latest user message as a standalone search query. "+
+			"Respond with only the rewritten query.\n\nLatest message: %s",
+		historyText.String(), userInput,
+	)
+
+	response, err := s.client.Chat.Completions.New(ctx, llamastackclient.ChatCompletionNewParams{
+		Messages: []llamastackclient.ChatCompletionNewParamsMessageUnion{
+			{
+				OfUser: &llamastackclient.ChatCompletionNewParamsMessageUser{
+					Content: llamastackclient.ChatCompletionNewParamsMessageUserContentUnion{
+						OfString: llamastackclient.String(prompt),
+					},
+				},
+			},
+		},
+		Model:     s.model,
+		MaxTokens: llamastackclient.Int(64),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	openAIResponse := response.AsOpenAIChatCompletion()
+	if len(openAIResponse.Choices) == 0 {
+		return userInput, nil
+	}
+
+	rewritten := strings.TrimSpace(openAIResponse.Choices[0].Message.AsAssistant().Content.OfString)
+	if rewritten == "" {
+		return userInput, nil
+	}
+	return rewritten, nil
+}
+
+// retrieve searches the vector store and diversifies the results with
+// MMR reranking.
+func (s *session) retrieve(ctx context.Context, query string) ([]rerank.Result, error) {
+	searchResults, err := s.client.VectorStores.Search(ctx, s.vectorStoreID, llamastackclient.VectorStoreSearchParams{
+		Query: llamastackclient.VectorStoreSearchParamsQueryUnion{
+			OfString: llamastackclient.String(query),
+		},
+		MaxNumResults: llamastackclient.Int(candidatePool),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	embedder := &llamaEmbedder{client: s.client}
+
+	candidates := make([]rerank.Result, len(searchResults.Data))
+	for i, result := range searchResults.Data {
+		content := searchResultText(result.Content)
+		embedding, err := embedder.Embed(ctx, content)
+		if err != nil {
+			return nil, err
+		}
+		candidates[i] = rerank.Result{
+			ID:        result.FileID,
+			Content:   content,
+			Score:     result.Score,
+			Embedding: embedding,
+		}
+	}
+
+	reranker := rerank.NewMMRReranker(embedder, 0.7)
+	return reranker.Rerank(ctx, query, candidates, topN)
+}
+
+// llamaEmbedder adapts the LlamaStack embeddings API to rerank.Embedder.
+type llamaEmbedder struct {
+	client *llamastackclient.Client
+}
+
+// Embed implements rerank.Embedder.
+func (e *llamaEmbedder) Embed(ctx context.Context, text string) ([]float64, error) {
+	resp, err := e.client.Embeddings.New(ctx, llamastackclient.EmbeddingNewParams{
+		Input: llamastackclient.EmbeddingNewParamsInputUnion{
+			OfString: llamastackclient.String(text),
+		},
+		Model: "all-MiniLM-L6-v2",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching embedding: %v", err)
+	}
+	if len(resp.Data) == 0 {
+		return nil, fmt.Errorf("error fetching embedding: no data returned")
+	}
+	return resp.Data[0].Embedding.AsFloatArray(), nil
+}
+
+// searchResultText concatenates the text of every content item in a
+// vector store search result into a single string, since a result can
+// carry more than one chunk of matched content.
+func searchResultText(contents []llamastackclient.VectorStoreSearchResponseDataContent) string {
+	var b strings.Builder
+	for i, c := range contents {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(c.Text)
+	}
+	return b.String()
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	llamastackclient "github.com/llamastack/llama-stack-client-go"
+)
+
+// streamAnswer generates a response to the current history using the
+// SDK's streaming variant, printing each token as it arrives, and
+// returns the full assembled answer so it can be appended to history.
+func (s *session) streamAnswer(ctx context.Context) (string, error) {
+	stream := s.client.Chat.Completions.NewStreaming(ctx, llamastackclient.ChatCompletionNewParams{
+		Messages:  s.history,
+		Model:     s.model,
+		MaxTokens: llamastackclient.Int(300),
+	})
+	defer stream.Close()
+
+	var answer string
+	for stream.Next() {
+		chunk := stream.Current()
+		if len(chunk.Choices) == 0 {
+			continue
+		}
+
+		delta := chunk.Choices[0].Delta.Content
+		if delta == "" {
+			continue
+		}
+
+		fmt.Print(delta)
+		answer += delta
+	}
+	fmt.Println()
+
+	if err := stream.Err(); err != nil {
+		return "", fmt.Errorf("streaming response: %v", err)
+	}
+
+	return answer, nil
+}
@@ -0,0 +1,235 @@
+// Command ragevaluate ingests a fixture corpus into a vector store and
+// reports retrieval and generation quality metrics, sweeping over
+// chunking strategy and top-K. Each config's Reranker is recorded in
+// its report row but no reranking is applied: every config retrieves
+// the vector store's own top-K order.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	llamastackclient "github.com/llamastack/llama-stack-client-go"
+	"github.com/llamastack/llama-stack-client-go/option"
+	"github.com/varshaprasad96/go-rag-demo/chunker"
+	"github.com/varshaprasad96/go-rag-demo/eval"
+	"github.com/varshaprasad96/go-rag-demo/vectorstore"
+)
+
+// ragevaluateConcurrency bounds how many chunks are polled for
+// processing status at once while waiting for a fresh eval vector store
+// to become queryable.
+const ragevaluateConcurrency = 4
+
+func main() {
+	llamaStackURL := flag.String("llama-stack-url", "http://localhost:8321", "LlamaStack server base URL")
+	fixturePath := flag.String("fixture", "", "path to a JSONL fixture of {question, ground_truth_answer, relevant_doc_ids}")
+	corpusPath := flag.String("corpus", "", "path to a text file to ingest as the fixture corpus")
+	format := flag.String("format", "markdown", "report format: markdown or json")
+	flag.Parse()
+
+	if *fixturePath == "" || *corpusPath == "" {
+		fmt.Fprintln(os.Stderr, "ragevaluate: -fixture and -corpus are required")
+		os.Exit(1)
+	}
+
+	if err := run(*llamaStackURL, *fixturePath, *corpusPath, *format); err != nil {
+		fmt.Fprintf(os.Stderr, "ragevaluate: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(llamaStackURL, fixturePath, corpusPath, format string) error {
+	ctx := context.Background()
+
+	examples, err := eval.LoadExamples(fixturePath)
+	if err != nil {
+		return err
+	}
+
+	corpusBytes, err := os.ReadFile(corpusPath)
+	if err != nil {
+		return fmt.Errorf("read corpus: %v", err)
+	}
+	corpus := string(corpusBytes)
+
+	client := llamastackclient.NewClient(option.WithBaseURL(llamaStackURL))
+
+	model, err := resolveModel(ctx, &client)
+	if err != nil {
+		return err
+	}
+
+	configs := []eval.Config{
+		{Strategy: string(chunker.StrategyFixedSize), TopK: 3, Reranker: "none"},
+		{Strategy: string(chunker.StrategyRecursive), TopK: 3, Reranker: "mmr"},
+		{Strategy: string(chunker.StrategyMarkdown), TopK: 5, Reranker: "mmr"},
+	}
+
+	runner := eval.NewRunner(eval.NewJudge(&client, model))
+
+	reports, err := runner.RunSweep(ctx, examples, configs, func(cfg eval.Config) (eval.RetrieveFunc, eval.GenerateFunc) {
+		return newPipeline(ctx, &client, model, corpus, cfg)
+	})
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case "json":
+		out, err := eval.WriteJSON(reports)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+	default:
+		fmt.Println(eval.WriteMarkdown(reports))
+	}
+
+	return nil
+}
+
+// newPipeline builds a fresh vector store for cfg, ingests corpus into
+// it with the configured chunking strategy, and returns retrieve/
+// generate functions for the eval.Runner to drive. cfg.Reranker is not
+// used.
+func newPipeline(ctx context.Context, client *llamastackclient.Client, model, corpus string, cfg eval.Config) (eval.RetrieveFunc, eval.GenerateFunc) {
+	vectorStore, splitErr := setupVectorStore(ctx, client, corpus, cfg)
+
+	retrieve := func(ctx context.Context, question string, topK int) ([]string, string, error) {
+		if splitErr != nil {
+			return nil, "", splitErr
+		}
+
+		results, err := client.VectorStores.Search(ctx, vectorStore.ID, llamastackclient.VectorStoreSearchParams{
+			Query: llamastackclient.VectorStoreSearchParamsQueryUnion{
+				OfString: llamastackclient.String(question),
+			},
+			MaxNumResults: llamastackclient.Int(int64(topK)),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		docIDs := make([]string, len(results.Data))
+		var contextText strings.Builder
+		for i, r := range results.Data {
+			docIDs[i] = r.FileID
+			fmt.Fprintf(&contextText, "%d. %s\n", i+1, searchResultText(r.Content))
+		}
+
+		return docIDs, contextText.String(), nil
+	}
+
+	generate := func(ctx context.Context, question, contextText string) (string, error) {
+		response, err := client.Chat.Completions.New(ctx, llamastackclient.ChatCompletionNewParams{
+			Messages: []llamastackclient.ChatCompletionNewParamsMessageUnion{
+				{
+					OfUser: &llamastackclient.ChatCompletionNewParamsMessageUser{
+						Content: llamastackclient.ChatCompletionNewParamsMessageUserContentUnion{
+							OfString: llamastackclient.String("Context:\n" + contextText + "\n\nQuestion: " + question),
+						},
+					},
+				},
+			},
+			Model:     model,
+			MaxTokens: llamastackclient.Int(300),
+		})
+		if err != nil {
+			return "", err
+		}
+
+		openAIResponse := response.AsOpenAIChatCompletion()
+		if len(openAIResponse.Choices) == 0 {
+			return "", nil
+		}
+		return openAIResponse.Choices[0].Message.AsAssistant().Content.OfString, nil
+	}
+
+	return retrieve, generate
+}
+
+// setupVectorStore creates a fresh vector store, splits corpus with
+// cfg.Strategy, and uploads+attaches each chunk.
+func setupVectorStore(ctx context.Context, client *llamastackclient.Client, corpus string, cfg eval.Config) (*llamastackclient.VectorStore, error) {
+	vectorStore, err := client.VectorStores.New(ctx, llamastackclient.VectorStoreNewParams{
+		Name: llamastackclient.String(fmt.Sprintf("eval-%s-top%d", cfg.Strategy, cfg.TopK)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create vector store: %v", err)
+	}
+
+	splitter, err := chunker.New(chunker.Strategy(cfg.Strategy), 1000, 200)
+	if err != nil {
+		return nil, fmt.Errorf("build chunker: %v", err)
+	}
+
+	chunks, err := splitter.Split("corpus", corpus)
+	if err != nil {
+		return nil, fmt.Errorf("split corpus: %v", err)
+	}
+
+	fileIDs := make([]string, 0, len(chunks))
+	for i, chunk := range chunks {
+		file, err := client.Files.New(ctx, llamastackclient.FileNewParams{
+			File:    llamastackclient.NewFile(strings.NewReader(chunk.Text), fmt.Sprintf("corpus.chunk-%04d.txt", i), "text/plain"),
+			Purpose: llamastackclient.FileNewParamsPurposeAssistants,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("upload chunk %d: %v", i, err)
+		}
+
+		if _, err := client.VectorStores.Files.New(ctx, vectorStore.ID, llamastackclient.VectorStoreFileNewParams{FileID: file.ID}); err != nil {
+			return nil, fmt.Errorf("attach chunk %d: %v", i, err)
+		}
+		fileIDs = append(fileIDs, file.ID)
+	}
+
+	// Wait for every chunk to finish processing before the returned
+	// vector store is queried; otherwise retrieve() can race an
+	// in-progress index and silently score against a partial corpus.
+	summary := vectorstore.WaitForFilesReady(ctx, client, vectorStore.ID, fileIDs, ragevaluateConcurrency, vectorstore.WaitOptions{})
+	if summary.Failed > 0 {
+		return nil, fmt.Errorf("%d of %d chunks failed to process", summary.Failed, len(fileIDs))
+	}
+
+	return vectorStore, nil
+}
+
+// resolveModel returns the identifier of the first available LLM.
+// Models.List only exposes the OpenAI-compatible listing (no
+// model_type), so each candidate is looked up individually via
+// Models.Get to find its native type.
+func resolveModel(ctx context.Context, client *llamastackclient.Client) (string, error) {
+	models, err := client.Models.List(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error fetching models: %v", err)
+	}
+	for _, m := range *models {
+		info, err := client.Models.Get(ctx, m.ID)
+		if err != nil {
+			return "", fmt.Errorf("error fetching model %s: %v", m.ID, err)
+		}
+		if info.ModelType == llamastackclient.ModelGetResponseModelTypeLlm {
+			return info.Identifier, nil
+		}
+	}
+	return "", fmt.Errorf("no LLM model available for generation")
+}
+
+// searchResultText concatenates the text of every content item in a
+// vector store search result into a single string, since a result can
+// carry more than one chunk of matched content.
+func searchResultText(contents []llamastackclient.VectorStoreSearchResponseDataContent) string {
+	var b strings.Builder
+	for i, c := range contents {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(c.Text)
+	}
+	return b.String()
+}
@@ -0,0 +1,36 @@
+// Command ragserver exposes the RAG pipeline behind an OpenAI-compatible
+// HTTP API, so tools built against the OpenAI SDK can point their
+// base_url at it and transparently get retrieval-augmented answers.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+
+	llamastackclient "github.com/llamastack/llama-stack-client-go"
+	"github.com/llamastack/llama-stack-client-go/option"
+	"github.com/varshaprasad96/go-rag-demo/server"
+)
+
+func main() {
+	llamaStackURL := flag.String("llama-stack-url", "http://localhost:8321", "LlamaStack server base URL")
+	vectorStoreID := flag.String("vector-store-id", "", "vector store to retrieve from for chat completions")
+	addr := flag.String("addr", ":8080", "address to listen on")
+	flag.Parse()
+
+	client := llamastackclient.NewClient(option.WithBaseURL(*llamaStackURL))
+
+	srv, err := server.New(&client, *vectorStoreID, *llamaStackURL)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ragserver: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("ragserver listening on %s, forwarding to %s\n", *addr, *llamaStackURL)
+	if err := http.ListenAndServe(*addr, srv); err != nil {
+		fmt.Fprintf(os.Stderr, "ragserver: %v\n", err)
+		os.Exit(1)
+	}
+}
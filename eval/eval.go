@@ -0,0 +1,53 @@
+// Package eval measures retrieval and end-to-end answer quality for the
+// RAG pipeline, so chunking strategy, top-K, and reranker choices can be
+// tuned against evidence instead of guesswork.
+package eval
+
+// Example is one labeled question from an evaluation fixture.
+type Example struct {
+	Question          string   `json:"question"`
+	GroundTruthAnswer string   `json:"ground_truth_answer"`
+	RelevantDocIDs    []string `json:"relevant_doc_ids"`
+}
+
+// Prediction is what the pipeline produced for one Example.
+type Prediction struct {
+	Example
+	// RetrievedDocIDs are the document IDs returned by retrieval, in
+	// rank order (best first).
+	RetrievedDocIDs []string
+	// ContextText is the rendered context text RetrieveFunc built from
+	// the retrieved chunks — the actual grounding Faithfulness checks
+	// GeneratedAnswer against, as opposed to GroundTruthAnswer.
+	ContextText string
+	// GeneratedAnswer is the final LLM answer.
+	GeneratedAnswer string
+}
+
+// RetrievalMetrics summarizes retrieval quality over a set of
+// Predictions, averaged across examples.
+type RetrievalMetrics struct {
+	RecallAtK float64
+	MRR       float64
+	NDCG      float64
+}
+
+// GenerationMetrics summarizes answer quality over a set of
+// Predictions, averaged across examples.
+type GenerationMetrics struct {
+	Faithfulness    float64
+	AnswerRelevance float64
+	ROUGE1          float64
+	BLEU            float64
+}
+
+// Report is the full output of an evaluation run.
+type Report struct {
+	Strategy  string `json:"strategy"`
+	TopK      int    `json:"top_k"`
+	Reranker  string `json:"reranker"`
+	NExamples int    `json:"n_examples"`
+
+	Retrieval  RetrievalMetrics  `json:"retrieval"`
+	Generation GenerationMetrics `json:"generation"`
+}
@@ -0,0 +1,78 @@
+package eval
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	llamastackclient "github.com/llamastack/llama-stack-client-go"
+)
+
+// Judge scores a generated answer using an LLM-as-judge prompt.
+type Judge struct {
+	client *llamastackclient.Client
+	model  string
+}
+
+// NewJudge returns a Judge that uses model via client to score answers.
+func NewJudge(client *llamastackclient.Client, model string) *Judge {
+	return &Judge{client: client, model: model}
+}
+
+// Faithfulness scores how well answer is supported by context, from 0.0
+// (unsupported/hallucinated) to 1.0 (fully supported).
+func (j *Judge) Faithfulness(ctx context.Context, context_, answer string) (float64, error) {
+	prompt := fmt.Sprintf(
+		"Context:\n%s\n\nAnswer:\n%s\n\n"+
+			"Rate from 0.0 to 1.0 how faithfully the answer is supported by the context, "+
+			"with 0.0 meaning it contains unsupported claims and 1.0 meaning every claim is grounded in the context. "+
+			"Respond with only the number.",
+		context_, answer,
+	)
+	return j.score(ctx, prompt)
+}
+
+// AnswerRelevance scores how relevant answer is to question, from 0.0
+// (off-topic) to 1.0 (directly answers the question).
+func (j *Judge) AnswerRelevance(ctx context.Context, question, answer string) (float64, error) {
+	prompt := fmt.Sprintf(
+		"Question:\n%s\n\nAnswer:\n%s\n\n"+
+			"Rate from 0.0 to 1.0 how relevant the answer is to the question. "+
+			"Respond with only the number.",
+		question, answer,
+	)
+	return j.score(ctx, prompt)
+}
+
+func (j *Judge) score(ctx context.Context, prompt string) (float64, error) {
+	response, err := j.client.Chat.Completions.New(ctx, llamastackclient.ChatCompletionNewParams{
+		Messages: []llamastackclient.ChatCompletionNewParamsMessageUnion{
+			{
+				OfUser: &llamastackclient.ChatCompletionNewParamsMessageUser{
+					Content: llamastackclient.ChatCompletionNewParamsMessageUserContentUnion{
+						OfString: llamastackclient.String(prompt),
+					},
+				},
+			},
+		},
+		Model:     j.model,
+		MaxTokens: llamastackclient.Int(8),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	openAIResponse := response.AsOpenAIChatCompletion()
+	if len(openAIResponse.Choices) == 0 {
+		return 0, fmt.Errorf("eval: no response from judge model")
+	}
+
+	text := strings.TrimSpace(openAIResponse.Choices[0].Message.AsAssistant().Content.OfString)
+	score, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return 0, fmt.Errorf("eval: parse judge score %q: %v", text, err)
+	}
+
+	return score, nil
+}
@@ -0,0 +1,40 @@
+package eval
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// LoadExamples reads a JSONL fixture of Example records from path, one
+// JSON object per line.
+func LoadExamples(path string) ([]Example, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("eval: open fixture %q: %v", path, err)
+	}
+	defer f.Close()
+
+	var examples []Example
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 1024*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		var ex Example
+		if err := json.Unmarshal(line, &ex); err != nil {
+			return nil, fmt.Errorf("eval: parse fixture line: %v", err)
+		}
+		examples = append(examples, ex)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("eval: read fixture %q: %v", path, err)
+	}
+
+	return examples, nil
+}
@@ -0,0 +1,72 @@
+package eval
+
+import "math"
+
+// RecallAtK returns the fraction of relevantDocIDs present anywhere in
+// the first k of retrievedDocIDs. It returns 0 if relevantDocIDs is
+// empty.
+func RecallAtK(retrievedDocIDs, relevantDocIDs []string, k int) float64 {
+	if len(relevantDocIDs) == 0 {
+		return 0
+	}
+	if k > len(retrievedDocIDs) {
+		k = len(retrievedDocIDs)
+	}
+
+	relevant := toSet(relevantDocIDs)
+	hits := 0
+	for _, id := range retrievedDocIDs[:k] {
+		if relevant[id] {
+			hits++
+		}
+	}
+
+	return float64(hits) / float64(len(relevantDocIDs))
+}
+
+// MRR returns the reciprocal rank of the first relevant document in
+// retrievedDocIDs, or 0 if none of relevantDocIDs appears.
+func MRR(retrievedDocIDs, relevantDocIDs []string) float64 {
+	relevant := toSet(relevantDocIDs)
+	for i, id := range retrievedDocIDs {
+		if relevant[id] {
+			return 1 / float64(i+1)
+		}
+	}
+	return 0
+}
+
+// NDCG returns the normalized discounted cumulative gain of
+// retrievedDocIDs against relevantDocIDs, using binary relevance.
+func NDCG(retrievedDocIDs, relevantDocIDs []string) float64 {
+	relevant := toSet(relevantDocIDs)
+
+	dcg := 0.0
+	for i, id := range retrievedDocIDs {
+		if relevant[id] {
+			dcg += 1 / math.Log2(float64(i+2))
+		}
+	}
+
+	idealHits := len(relevantDocIDs)
+	if idealHits > len(retrievedDocIDs) {
+		idealHits = len(retrievedDocIDs)
+	}
+	idcg := 0.0
+	for i := 0; i < idealHits; i++ {
+		idcg += 1 / math.Log2(float64(i+2))
+	}
+	if idcg == 0 {
+		return 0
+	}
+
+	return dcg / idcg
+}
+
+func toSet(ids []string) map[string]bool {
+	set := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return set
+}
@@ -0,0 +1,62 @@
+package eval
+
+import (
+	"math"
+	"testing"
+)
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestRecallAtK(t *testing.T) {
+	retrieved := []string{"a", "b", "c"}
+	relevant := []string{"b", "d"}
+
+	got := RecallAtK(retrieved, relevant, 2)
+	if want := 0.5; !almostEqual(got, want) {
+		t.Errorf("RecallAtK() = %v, want %v", got, want)
+	}
+}
+
+func TestRecallAtK_NoRelevantDocs(t *testing.T) {
+	got := RecallAtK([]string{"a"}, nil, 1)
+	if got != 0 {
+		t.Errorf("RecallAtK() with no relevant docs = %v, want 0", got)
+	}
+}
+
+func TestRecallAtK_KLargerThanRetrieved(t *testing.T) {
+	got := RecallAtK([]string{"a", "b"}, []string{"b"}, 10)
+	if want := 1.0; !almostEqual(got, want) {
+		t.Errorf("RecallAtK() = %v, want %v", got, want)
+	}
+}
+
+func TestMRR(t *testing.T) {
+	got := MRR([]string{"x", "y", "z"}, []string{"y"})
+	if want := 0.5; !almostEqual(got, want) {
+		t.Errorf("MRR() = %v, want %v", got, want)
+	}
+}
+
+func TestMRR_NoMatch(t *testing.T) {
+	got := MRR([]string{"x", "y"}, []string{"z"})
+	if got != 0 {
+		t.Errorf("MRR() with no match = %v, want 0", got)
+	}
+}
+
+func TestNDCG(t *testing.T) {
+	got := NDCG([]string{"a", "b", "c"}, []string{"b", "c"})
+	if want := 0.6934264036172708; !almostEqual(got, want) {
+		t.Errorf("NDCG() = %v, want %v", got, want)
+	}
+}
+
+func TestNDCG_NoRelevantDocs(t *testing.T) {
+	got := NDCG([]string{"a", "b"}, nil)
+	if got != 0 {
+		t.Errorf("NDCG() with no relevant docs = %v, want 0", got)
+	}
+}
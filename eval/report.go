@@ -0,0 +1,31 @@
+package eval
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// WriteJSON marshals reports as indented JSON.
+func WriteJSON(reports []*Report) ([]byte, error) {
+	return json.MarshalIndent(reports, "", "  ")
+}
+
+// WriteMarkdown renders reports as a Markdown table, one row per
+// config swept.
+func WriteMarkdown(reports []*Report) string {
+	var b strings.Builder
+	b.WriteString("| Strategy | TopK | Reranker | N | Recall@K | MRR | nDCG | ROUGE-1 | BLEU | Faithfulness | Relevance |\n")
+	b.WriteString("|---|---|---|---|---|---|---|---|---|---|---|\n")
+
+	for _, r := range reports {
+		fmt.Fprintf(&b, "| %s | %d | %s | %d | %.3f | %.3f | %.3f | %.3f | %.3f | %.3f | %.3f |\n",
+			r.Strategy, r.TopK, r.Reranker, r.NExamples,
+			r.Retrieval.RecallAtK, r.Retrieval.MRR, r.Retrieval.NDCG,
+			r.Generation.ROUGE1, r.Generation.BLEU,
+			r.Generation.Faithfulness, r.Generation.AnswerRelevance,
+		)
+	}
+
+	return b.String()
+}
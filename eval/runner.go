@@ -0,0 +1,124 @@
+package eval
+
+import "context"
+
+// RetrieveFunc retrieves context for question, returning the retrieved
+// document IDs (best first, for Recall@K/MRR/nDCG) and the rendered
+// context text to feed into generation.
+type RetrieveFunc func(ctx context.Context, question string, topK int) (docIDs []string, contextText string, err error)
+
+// GenerateFunc produces an answer to question given contextText.
+type GenerateFunc func(ctx context.Context, question, contextText string) (answer string, err error)
+
+// Config identifies one point in the chunking/top-K/reranker space to
+// sweep over. Runner itself uses only TopK, passing it to RetrieveFunc
+// and using it as k for Recall@K; the newPipeline function passed to
+// RunSweep decides how Strategy and Reranker shape retrieval.
+type Config struct {
+	Strategy string
+	TopK     int
+	Reranker string
+}
+
+// Runner evaluates a RAG pipeline against a set of labeled Examples.
+type Runner struct {
+	Judge *Judge
+}
+
+// NewRunner returns a Runner that scores generation quality with judge.
+// judge may be nil to skip the LLM-as-judge metrics (retrieval metrics
+// and ROUGE/BLEU still run).
+func NewRunner(judge *Judge) *Runner {
+	return &Runner{Judge: judge}
+}
+
+// Run executes retrieve and generate over every example, and reports
+// the averaged retrieval and generation metrics under cfg.
+func (r *Runner) Run(ctx context.Context, examples []Example, cfg Config, retrieve RetrieveFunc, generate GenerateFunc) (*Report, error) {
+	var predictions []Prediction
+
+	for _, ex := range examples {
+		docIDs, contextText, err := retrieve(ctx, ex.Question, cfg.TopK)
+		if err != nil {
+			return nil, err
+		}
+
+		answer, err := generate(ctx, ex.Question, contextText)
+		if err != nil {
+			return nil, err
+		}
+
+		predictions = append(predictions, Prediction{
+			Example:         ex,
+			RetrievedDocIDs: docIDs,
+			ContextText:     contextText,
+			GeneratedAnswer: answer,
+		})
+	}
+
+	return r.score(ctx, predictions, cfg)
+}
+
+// score computes the averaged Report metrics over predictions.
+func (r *Runner) score(ctx context.Context, predictions []Prediction, cfg Config) (*Report, error) {
+	report := &Report{
+		Strategy:  cfg.Strategy,
+		TopK:      cfg.TopK,
+		Reranker:  cfg.Reranker,
+		NExamples: len(predictions),
+	}
+	if len(predictions) == 0 {
+		return report, nil
+	}
+
+	for _, p := range predictions {
+		report.Retrieval.RecallAtK += RecallAtK(p.RetrievedDocIDs, p.RelevantDocIDs, cfg.TopK)
+		report.Retrieval.MRR += MRR(p.RetrievedDocIDs, p.RelevantDocIDs)
+		report.Retrieval.NDCG += NDCG(p.RetrievedDocIDs, p.RelevantDocIDs)
+
+		report.Generation.ROUGE1 += rouge1(p.GeneratedAnswer, p.GroundTruthAnswer)
+		report.Generation.BLEU += bleu(p.GeneratedAnswer, p.GroundTruthAnswer)
+
+		if r.Judge != nil {
+			relevance, err := r.Judge.AnswerRelevance(ctx, p.Question, p.GeneratedAnswer)
+			if err != nil {
+				return nil, err
+			}
+			report.Generation.AnswerRelevance += relevance
+
+			faithfulness, err := r.Judge.Faithfulness(ctx, p.ContextText, p.GeneratedAnswer)
+			if err != nil {
+				return nil, err
+			}
+			report.Generation.Faithfulness += faithfulness
+		}
+	}
+
+	n := float64(len(predictions))
+	report.Retrieval.RecallAtK /= n
+	report.Retrieval.MRR /= n
+	report.Retrieval.NDCG /= n
+	report.Generation.ROUGE1 /= n
+	report.Generation.BLEU /= n
+	if r.Judge != nil {
+		report.Generation.AnswerRelevance /= n
+		report.Generation.Faithfulness /= n
+	}
+
+	return report, nil
+}
+
+// RunSweep evaluates the pipeline built by newPipeline for each of
+// configs, returning one Report per config in the same order.
+func (r *Runner) RunSweep(ctx context.Context, examples []Example, configs []Config, newPipeline func(Config) (RetrieveFunc, GenerateFunc)) ([]*Report, error) {
+	reports := make([]*Report, 0, len(configs))
+	for _, cfg := range configs {
+		retrieve, generate := newPipeline(cfg)
+		report, err := r.Run(ctx, examples, cfg, retrieve, generate)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
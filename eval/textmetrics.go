@@ -0,0 +1,110 @@
+package eval
+
+import (
+	"math"
+	"strings"
+)
+
+// rouge1 returns the unigram-overlap F1 score (ROUGE-1) between a
+// candidate and a reference string.
+func rouge1(candidate, reference string) float64 {
+	candTokens := tokenize(candidate)
+	refTokens := tokenize(reference)
+	if len(candTokens) == 0 || len(refTokens) == 0 {
+		return 0
+	}
+
+	refCounts := counts(refTokens)
+	overlap := 0
+	candCounts := counts(candTokens)
+	for tok, n := range candCounts {
+		if refN := refCounts[tok]; refN > 0 {
+			if n < refN {
+				overlap += n
+			} else {
+				overlap += refN
+			}
+		}
+	}
+
+	precision := float64(overlap) / float64(len(candTokens))
+	recall := float64(overlap) / float64(len(refTokens))
+	if precision+recall == 0 {
+		return 0
+	}
+
+	return 2 * precision * recall / (precision + recall)
+}
+
+// bleu returns a simplified BLEU-4 score (with a brevity penalty) for a
+// candidate against a single reference string.
+func bleu(candidate, reference string) float64 {
+	candTokens := tokenize(candidate)
+	refTokens := tokenize(reference)
+	if len(candTokens) == 0 || len(refTokens) == 0 {
+		return 0
+	}
+
+	logSum := 0.0
+	validN := 0
+	for n := 1; n <= 4; n++ {
+		candNgrams := ngrams(candTokens, n)
+		refNgrams := ngrams(refTokens, n)
+		if len(candNgrams) == 0 {
+			continue
+		}
+
+		refCounts := counts(refNgrams)
+		candCounts := counts(candNgrams)
+		matches := 0
+		for ng, c := range candCounts {
+			if refN := refCounts[ng]; refN > 0 {
+				if c < refN {
+					matches += c
+				} else {
+					matches += refN
+				}
+			}
+		}
+
+		precision := float64(matches) / float64(len(candNgrams))
+		if precision == 0 {
+			return 0
+		}
+		logSum += math.Log(precision)
+		validN++
+	}
+	if validN == 0 {
+		return 0
+	}
+
+	brevityPenalty := 1.0
+	if len(candTokens) < len(refTokens) {
+		brevityPenalty = math.Exp(1 - float64(len(refTokens))/float64(len(candTokens)))
+	}
+
+	return brevityPenalty * math.Exp(logSum/float64(validN))
+}
+
+func tokenize(s string) []string {
+	return strings.Fields(strings.ToLower(s))
+}
+
+func counts(tokens []string) map[string]int {
+	m := make(map[string]int, len(tokens))
+	for _, t := range tokens {
+		m[t]++
+	}
+	return m
+}
+
+func ngrams(tokens []string, n int) []string {
+	if len(tokens) < n {
+		return nil
+	}
+	out := make([]string, 0, len(tokens)-n+1)
+	for i := 0; i+n <= len(tokens); i++ {
+		out = append(out, strings.Join(tokens[i:i+n], " "))
+	}
+	return out
+}
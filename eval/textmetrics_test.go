@@ -0,0 +1,37 @@
+package eval
+
+import "testing"
+
+func TestRouge1(t *testing.T) {
+	got := rouge1("the cat sat on the mat", "the cat is on the mat")
+	if want := 0.8333333333333334; !almostEqual(got, want) {
+		t.Errorf("rouge1() = %v, want %v", got, want)
+	}
+}
+
+func TestRouge1_EmptyInput(t *testing.T) {
+	if got := rouge1("", "the cat sat"); got != 0 {
+		t.Errorf("rouge1() with empty candidate = %v, want 0", got)
+	}
+}
+
+func TestBLEU_IdenticalStrings(t *testing.T) {
+	got := bleu("the cat sat on the mat", "the cat sat on the mat")
+	if want := 1.0; !almostEqual(got, want) {
+		t.Errorf("bleu() for identical strings = %v, want %v", got, want)
+	}
+}
+
+func TestBLEU_NoNgramOverlap(t *testing.T) {
+	got := bleu("the cat sat on the mat", "a dog ran through the park")
+	if got != 0 {
+		t.Errorf("bleu() with no 4-gram overlap = %v, want 0", got)
+	}
+}
+
+func TestBLEU_AppliesBrevityPenalty(t *testing.T) {
+	got := bleu("the cat sat", "the cat sat on the mat")
+	if want := 0.36787944117144233; !almostEqual(got, want) {
+		t.Errorf("bleu() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,41 @@
+package ingest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"code.sajari.com/docconv/v2"
+)
+
+// DOCXLoader loads text from Word (.docx) documents. DOCX has no page
+// boundaries at the file-format level, so the whole document is
+// returned as a single Page.
+type DOCXLoader struct{}
+
+// NewDOCXLoader returns a loader for .docx files.
+func NewDOCXLoader() *DOCXLoader {
+	return &DOCXLoader{}
+}
+
+// CanLoad implements DocumentLoader.
+func (l *DOCXLoader) CanLoad(path string) bool {
+	return strings.EqualFold(strings.TrimPrefix(extOf(path), "."), "docx")
+}
+
+// Load implements DocumentLoader.
+func (l *DOCXLoader) Load(ctx context.Context, path string) (*Document, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	res, err := docconv.ConvertPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("ingest: convert docx %q: %v", path, err)
+	}
+
+	return &Document{
+		SourcePath: path,
+		Pages:      []Page{{Number: 1, Text: res.Body}},
+	}, nil
+}
@@ -0,0 +1,75 @@
+package ingest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// HTMLLoader loads text from .html/.htm files by stripping markup and
+// keeping the visible text content.
+type HTMLLoader struct{}
+
+// NewHTMLLoader returns a loader for .html/.htm files.
+func NewHTMLLoader() *HTMLLoader {
+	return &HTMLLoader{}
+}
+
+// CanLoad implements DocumentLoader.
+func (l *HTMLLoader) CanLoad(path string) bool {
+	ext := strings.ToLower(strings.TrimPrefix(extOf(path), "."))
+	return ext == "html" || ext == "htm"
+}
+
+// Load implements DocumentLoader. The whole file is returned as a
+// single Page, since HTML has no native page boundaries.
+func (l *HTMLLoader) Load(ctx context.Context, path string) (*Document, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("ingest: open html %q: %v", path, err)
+	}
+	defer f.Close()
+
+	node, err := html.Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("ingest: parse html %q: %v", path, err)
+	}
+
+	var text strings.Builder
+	extractText(node, &text)
+
+	return &Document{
+		SourcePath: path,
+		Pages:      []Page{{Number: 1, Text: strings.TrimSpace(text.String())}},
+	}, nil
+}
+
+// extractText walks the HTML node tree, appending the text of every
+// text node and skipping script/style content.
+func extractText(n *html.Node, out *strings.Builder) {
+	if n.Type == html.TextNode {
+		out.WriteString(n.Data)
+	}
+	if n.Type == html.ElementNode && (n.Data == "script" || n.Data == "style") {
+		return
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		extractText(c, out)
+	}
+}
+
+// extOf returns the file extension of path, including the leading dot,
+// or "" if there is none.
+func extOf(path string) string {
+	if i := strings.LastIndex(path, "."); i != -1 {
+		return path[i:]
+	}
+	return ""
+}
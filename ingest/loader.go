@@ -0,0 +1,71 @@
+// Package ingest loads real-world documents (PDF, DOCX, HTML, scanned
+// images) into normalized text suitable for chunking and upload to a
+// LlamaStack vector store.
+package ingest
+
+import "context"
+
+// Page is one page (or page-like unit) of a loaded document, along with
+// the metadata needed to cite it back to the user.
+type Page struct {
+	// Number is the 1-indexed page number within the source document.
+	Number int
+	// Text is the normalized, extracted text for this page.
+	Text string
+	// OCR is true if the text was recovered via an OCR fallback rather
+	// than a native text layer.
+	OCR bool
+}
+
+// Document is the result of loading a single source file.
+type Document struct {
+	// SourcePath is the path (or identifier) the document was loaded from.
+	SourcePath string
+	// Pages holds the per-page text and metadata, in order.
+	Pages []Page
+}
+
+// Text concatenates all page text into a single string, separated by
+// blank lines, for callers that don't care about page boundaries.
+func (d *Document) Text() string {
+	var out string
+	for i, p := range d.Pages {
+		if i > 0 {
+			out += "\n\n"
+		}
+		out += p.Text
+	}
+	return out
+}
+
+// DocumentLoader loads a single document from disk and returns its
+// normalized text content, split into pages.
+type DocumentLoader interface {
+	// Load reads the file at path and returns its extracted content.
+	Load(ctx context.Context, path string) (*Document, error)
+
+	// CanLoad reports whether this loader handles the given file, based
+	// on its extension or content.
+	CanLoad(path string) bool
+}
+
+// Loaders returns the default set of document loaders, covering PDF,
+// DOCX, and HTML sources. Callers pick a loader with LoaderFor.
+func Loaders() []DocumentLoader {
+	return []DocumentLoader{
+		NewPDFLoader(),
+		NewDOCXLoader(),
+		NewHTMLLoader(),
+	}
+}
+
+// LoaderFor returns the first registered loader that can handle path, or
+// nil if none match.
+func LoaderFor(path string) DocumentLoader {
+	for _, l := range Loaders() {
+		if l.CanLoad(path) {
+			return l
+		}
+	}
+	return nil
+}
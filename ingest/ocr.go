@@ -0,0 +1,26 @@
+package ingest
+
+import (
+	"fmt"
+
+	"github.com/otiai10/gosseract/v2"
+)
+
+// ocrImage runs Tesseract OCR over the given image bytes and returns the
+// recognized text. It is used as a fallback when a PDF page has no
+// extractable text layer (e.g. a scanned document).
+func ocrImage(imageBytes []byte) (string, error) {
+	client := gosseract.NewClient()
+	defer client.Close()
+
+	if err := client.SetImageFromBytes(imageBytes); err != nil {
+		return "", fmt.Errorf("ocr: set image: %v", err)
+	}
+
+	text, err := client.Text()
+	if err != nil {
+		return "", fmt.Errorf("ocr: recognize text: %v", err)
+	}
+
+	return text, nil
+}
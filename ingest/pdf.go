@@ -0,0 +1,74 @@
+package ingest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ledongthuc/pdf"
+)
+
+// PDFLoader loads text from PDF files, falling back to OCR for pages
+// that have no extractable text layer (e.g. scanned documents).
+type PDFLoader struct{}
+
+// NewPDFLoader returns a loader for .pdf files.
+func NewPDFLoader() *PDFLoader {
+	return &PDFLoader{}
+}
+
+// CanLoad implements DocumentLoader.
+func (l *PDFLoader) CanLoad(path string) bool {
+	return strings.EqualFold(strings.TrimPrefix(extOf(path), "."), "pdf")
+}
+
+// Load implements DocumentLoader. Each PDF page becomes one Page; pages
+// with no native text are rendered to an image and run through OCR.
+func (l *PDFLoader) Load(ctx context.Context, path string) (*Document, error) {
+	f, r, err := pdf.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("ingest: open pdf %q: %v", path, err)
+	}
+	defer f.Close()
+
+	doc := &Document{SourcePath: path}
+
+	for i := 1; i <= r.NumPage(); i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		page := r.Page(i)
+		if page.V.IsNull() {
+			continue
+		}
+
+		text, err := page.GetPlainText(nil)
+		if err != nil {
+			return nil, fmt.Errorf("ingest: extract text from page %d of %q: %v", i, path, err)
+		}
+
+		if strings.TrimSpace(text) != "" {
+			doc.Pages = append(doc.Pages, Page{Number: i, Text: text})
+			continue
+		}
+
+		// No text layer on this page; rasterize it and fall back to OCR.
+		// github.com/ledongthuc/pdf is text-extraction only (it has no
+		// content-stream/graphics interpreter), so rendering is done by
+		// shelling out to poppler's pdftoppm rather than in-process.
+		imageBytes, err := rasterizePage(ctx, path, i)
+		if err != nil {
+			return nil, fmt.Errorf("ingest: render page %d of %q for ocr: %v", i, path, err)
+		}
+
+		ocrText, err := ocrImage(imageBytes)
+		if err != nil {
+			return nil, fmt.Errorf("ingest: ocr page %d of %q: %v", i, path, err)
+		}
+
+		doc.Pages = append(doc.Pages, Page{Number: i, Text: ocrText, OCR: true})
+	}
+
+	return doc, nil
+}
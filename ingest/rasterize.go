@@ -0,0 +1,41 @@
+package ingest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+)
+
+// rasterizePage renders page (1-indexed) of the PDF at path to a PNG
+// image, using poppler's pdftoppm, and returns the encoded image bytes.
+// pdftoppm is required on PATH; ledongthuc/pdf has no content-stream
+// interpreter of its own to rasterize with.
+func rasterizePage(ctx context.Context, path string, page int) ([]byte, error) {
+	tmpDir, err := os.MkdirTemp("", "ingest-pdf-ocr")
+	if err != nil {
+		return nil, fmt.Errorf("create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	outPrefix := filepath.Join(tmpDir, "page")
+	pageArg := strconv.Itoa(page)
+
+	cmd := exec.CommandContext(ctx, "pdftoppm",
+		"-f", pageArg, "-l", pageArg,
+		"-png", "-singlefile", "-r", "300",
+		path, outPrefix,
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("pdftoppm: %v: %s", err, out)
+	}
+
+	imageBytes, err := os.ReadFile(outPrefix + ".png")
+	if err != nil {
+		return nil, fmt.Errorf("read rendered page: %v", err)
+	}
+
+	return imageBytes, nil
+}
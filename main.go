@@ -2,14 +2,42 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 
 	llamastackclient "github.com/llamastack/llama-stack-client-go"
 	"github.com/llamastack/llama-stack-client-go/option"
+	"github.com/varshaprasad96/go-rag-demo/chunker"
+	"github.com/varshaprasad96/go-rag-demo/ingest"
+	"github.com/varshaprasad96/go-rag-demo/rerank"
+	"github.com/varshaprasad96/go-rag-demo/vectorstore"
 )
 
+// rerankCandidatePool is how many top results to pull from the vector
+// store before reranking (MaxNumResults); rerankTopN is how many of
+// those survive reranking to build the generation context.
+const (
+	rerankCandidatePool = 10
+	rerankTopN          = 3
+)
+
+// docPath, when set via the RAG_DEMO_DOC_PATH environment variable,
+// points at a PDF/DOCX/HTML file to ingest instead of the built-in
+// sample text. This lets the demo double as a smoke test for the
+// ingest package against real-world documents (e.g. scanned S-1/K-1
+// filings) without changing the default zero-config behavior.
+const docPathEnvVar = "RAG_DEMO_DOC_PATH"
+
 func main() {
+	strategy := flag.String("chunk-strategy", string(chunker.StrategyRecursive),
+		"chunking strategy: fixed-size, recursive, token, or markdown")
+	chunkSize := flag.Int("chunk-size", 1000, "target chunk size (characters, or tokens for the token strategy)")
+	chunkOverlap := flag.Int("chunk-overlap", 200, "overlap between consecutive chunks")
+	flag.Parse()
+
 	// Create a new LlamaStack client configured for local instance
 	client := llamastackclient.NewClient(
 		option.WithBaseURL("http://localhost:8321"),
@@ -18,7 +46,7 @@ func main() {
 	fmt.Println("=== LlamaStack RAG Demo ===\n")
 
 	// Run the RAG demo
-	if err := runRAGDemo(&client); err != nil {
+	if err := runRAGDemo(&client, chunker.Strategy(*strategy), *chunkSize, *chunkOverlap); err != nil {
 		fmt.Printf("RAG Demo failed: %v\n", err)
 		return
 	}
@@ -27,7 +55,7 @@ func main() {
 }
 
 // runRAGDemo demonstrates a complete RAG pipeline using LlamaStack
-func runRAGDemo(client *llamastackclient.Client) error {
+func runRAGDemo(client *llamastackclient.Client, strategy chunker.Strategy, chunkSize, chunkOverlap int) error {
 	ctx := context.Background()
 
 	// Step 1: Create a vector store
@@ -40,50 +68,79 @@ func runRAGDemo(client *llamastackclient.Client) error {
 	}
 	fmt.Printf("Created vector store: %s (ID: %s)\n", vectorStore.Name, vectorStore.ID)
 
-	// Step 2: Upload a sample text file to the Files service first
-	fmt.Println("\n=== Step 2: Uploading Sample File ===")
+	// Step 2: Load the source content and split it into chunks
+	fmt.Println("\n=== Step 2: Splitting Source Content ===")
 
-	// Create sample content
-	sampleContent := `Artificial Intelligence (AI) is a branch of computer science that aims to create intelligent machines that work and react like humans. 
-	
-Machine Learning is a subset of AI that enables computers to learn and improve from experience without being explicitly programmed. 
-	
-Deep Learning is a subset of machine learning that uses neural networks with multiple layers to model and understand complex patterns in data.
-
-Natural Language Processing (NLP) is a field of AI that focuses on the interaction between computers and human language, enabling machines to understand, interpret, and generate human language.
-
-Computer Vision is another AI field that enables computers to interpret and understand visual information from the world, such as images and videos.
+	docID := "ai_concepts"
+	baseName := "ai_concepts.txt"
+	mimeType := "text/plain"
+	sourceText := sampleText
 
-These technologies are transforming industries like healthcare, finance, transportation, and entertainment by automating tasks, improving decision-making, and creating new capabilities.`
+	if docPath := os.Getenv(docPathEnvVar); docPath != "" {
+		loader := ingest.LoaderFor(docPath)
+		if loader == nil {
+			return fmt.Errorf("error loading document: no ingest loader registered for %q", docPath)
+		}
+		doc, err := loader.Load(ctx, docPath)
+		if err != nil {
+			return fmt.Errorf("error loading document %q: %v", docPath, err)
+		}
+		docID = docPath
+		baseName = filepath.Base(docPath)
+		mimeType = mimeTypeFor(docPath)
+		sourceText = doc.Text()
+	}
 
-	// Create a file reader from the sample content
-	fileReader := strings.NewReader(sampleContent)
+	splitter, err := chunker.New(strategy, chunkSize, chunkOverlap)
+	if err != nil {
+		return fmt.Errorf("error building chunker: %v", err)
+	}
 
-	// First upload file to the Files service
-	file, err := client.Files.New(ctx, llamastackclient.FileNewParams{
-		File:    llamastackclient.NewFile(fileReader, "ai_concepts.txt", "text/plain"),
-		Purpose: llamastackclient.FileNewParamsPurposeAssistants,
-	})
+	chunks, err := splitter.Split(docID, sourceText)
 	if err != nil {
-		return fmt.Errorf("error uploading file: %v", err)
+		return fmt.Errorf("error splitting content: %v", err)
 	}
-	fmt.Printf("Uploaded file: %s (ID: %s)\n", file.Filename, file.ID)
+	fmt.Printf("Split %q into %d chunk(s) using the %q strategy\n", baseName, len(chunks), strategy)
 
-	// Step 3: Attach the file to the vector store
-	fmt.Println("\n=== Step 3: Attaching File to Vector Store ===")
+	// Step 3: Upload each chunk as its own file and attach it to the vector store
+	fmt.Println("\n=== Step 3: Uploading Chunks to Vector Store ===")
 
-	// Attach file to vector store
-	_, err = client.VectorStores.Files.New(ctx, vectorStore.ID, llamastackclient.VectorStoreFileNewParams{
-		FileID: file.ID,
-	})
-	if err != nil {
-		return fmt.Errorf("error attaching file to vector store: %v", err)
+	fileIDs := make([]string, 0, len(chunks))
+	for i, chunk := range chunks {
+		chunkName := fmt.Sprintf("%s.chunk-%04d%s", strings.TrimSuffix(baseName, filepath.Ext(baseName)), i, filepath.Ext(baseName))
+
+		file, err := client.Files.New(ctx, llamastackclient.FileNewParams{
+			File:    llamastackclient.NewFile(strings.NewReader(chunk.Text), chunkName, mimeType),
+			Purpose: llamastackclient.FileNewParamsPurposeAssistants,
+		})
+		if err != nil {
+			return fmt.Errorf("error uploading chunk %d: %v", i, err)
+		}
+
+		_, err = client.VectorStores.Files.New(ctx, vectorStore.ID, llamastackclient.VectorStoreFileNewParams{
+			FileID: file.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("error attaching chunk %d to vector store: %v", i, err)
+		}
+		fileIDs = append(fileIDs, file.ID)
 	}
-	fmt.Printf("File attached to vector store successfully\n")
+	fmt.Printf("Uploaded and attached %d chunk(s) to the vector store\n", len(chunks))
 
-	// Wait a moment for processing
+	// Wait for every chunk to finish processing before querying, instead
+	// of a blind sleep. Queries issued while a file is still processing
+	// silently miss its chunks.
 	fmt.Println("Waiting for file processing to complete...")
-	// In a real application, you might want to poll the file status
+	summary := vectorstore.WaitForFilesReady(ctx, client, vectorStore.ID, fileIDs, 4, vectorstore.WaitOptions{})
+	if summary.Failed > 0 {
+		for _, r := range summary.Results {
+			if r.Err != nil {
+				fmt.Printf("  file %s: %v\n", r.FileID, r.Err)
+			}
+		}
+		return fmt.Errorf("error processing files: %d of %d chunks failed", summary.Failed, len(fileIDs))
+	}
+	fmt.Printf("All %d chunk(s) finished processing\n", summary.Completed)
 
 	// Step 4: Run a query against the vector store
 	fmt.Println("\n=== Step 4: Running RAG Query ===")
@@ -91,19 +148,47 @@ These technologies are transforming industries like healthcare, finance, transpo
 	query := "What is machine learning and how does it relate to AI?"
 	fmt.Printf("Query: %s\n", query)
 
-	// Search the vector store
+	// Search the vector store for a wide candidate pool; reranking below
+	// narrows it down to the chunks that actually go into the context.
 	searchResults, err := client.VectorStores.Search(ctx, vectorStore.ID, llamastackclient.VectorStoreSearchParams{
 		Query: llamastackclient.VectorStoreSearchParamsQueryUnion{
 			OfString: llamastackclient.String(query),
 		},
-		MaxNumResults: llamastackclient.Int(3), // Get top 3 results
+		MaxNumResults: llamastackclient.Int(rerankCandidatePool),
 	})
 	if err != nil {
 		return fmt.Errorf("error searching vector store: %v", err)
 	}
+	fmt.Printf("\nFound %d candidate chunk(s)\n", len(searchResults.Data))
 
-	fmt.Printf("\nFound %d relevant chunks:\n", len(searchResults.Data))
+	// Step 4b: Rerank the candidates with MMR to diversify the results
+	fmt.Println("\n=== Step 4b: Reranking Candidates ===")
+
+	embedder := &llamaEmbedder{client: client}
+
+	candidates := make([]rerank.Result, len(searchResults.Data))
 	for i, result := range searchResults.Data {
+		content := searchResultText(result.Content)
+		embedding, err := embedder.Embed(ctx, content)
+		if err != nil {
+			return fmt.Errorf("error embedding candidate chunk %d: %v", i, err)
+		}
+		candidates[i] = rerank.Result{
+			ID:        result.FileID,
+			Content:   content,
+			Score:     result.Score,
+			Embedding: embedding,
+		}
+	}
+
+	reranker := rerank.NewMMRReranker(embedder, 0.7)
+	reranked, err := reranker.Rerank(ctx, query, candidates, rerankTopN)
+	if err != nil {
+		return fmt.Errorf("error reranking candidates: %v", err)
+	}
+
+	fmt.Printf("Reranked down to %d chunk(s):\n", len(reranked))
+	for i, result := range reranked {
 		fmt.Printf("\n--- Chunk %d ---\n", i+1)
 		fmt.Printf("Content: %s\n", result.Content)
 		fmt.Printf("Score: %.4f\n", result.Score)
@@ -115,12 +200,14 @@ These technologies are transforming industries like healthcare, finance, transpo
 	// Combine retrieved chunks into context
 	var contextBuilder strings.Builder
 	contextBuilder.WriteString("Based on the following information:\n\n")
-	for i, result := range searchResults.Data {
+	for i, result := range reranked {
 		contextBuilder.WriteString(fmt.Sprintf("%d. %s\n", i+1, result.Content))
 	}
 	contextBuilder.WriteString("\nPlease answer the question: " + query)
 
-	// Get available LLM models for generation
+	// Get available LLM models for generation. Models.List only exposes
+	// the OpenAI-compatible listing (no model_type), so each candidate is
+	// looked up individually via Models.Get to find its native type.
 	models, err := client.Models.List(ctx)
 	if err != nil {
 		return fmt.Errorf("error fetching models: %v", err)
@@ -128,8 +215,12 @@ These technologies are transforming industries like healthcare, finance, transpo
 
 	var llmModel string
 	for _, model := range *models {
-		if model.ModelType == "llm" {
-			llmModel = model.Identifier
+		info, err := client.Models.Get(ctx, model.ID)
+		if err != nil {
+			return fmt.Errorf("error fetching model %s: %v", model.ID, err)
+		}
+		if info.ModelType == llamastackclient.ModelGetResponseModelTypeLlm {
+			llmModel = info.Identifier
 			break
 		}
 	}
@@ -138,8 +229,9 @@ These technologies are transforming industries like healthcare, finance, transpo
 		return fmt.Errorf("no LLM model available for generation")
 	}
 
-	// Generate answer using the retrieved context
-	response, err := client.Chat.Completions.New(ctx, llamastackclient.ChatCompletionNewParams{
+	// Stream the generated answer, printing tokens as they arrive
+	fmt.Printf("\nEnhanced Answer:\n")
+	stream := client.Chat.Completions.NewStreaming(ctx, llamastackclient.ChatCompletionNewParams{
 		Messages: []llamastackclient.ChatCompletionNewParamsMessageUnion{
 			{
 				OfSystem: &llamastackclient.ChatCompletionNewParamsMessageSystem{
@@ -159,20 +251,84 @@ These technologies are transforming industries like healthcare, finance, transpo
 		Model:     llmModel,
 		MaxTokens: llamastackclient.Int(300),
 	})
+	defer stream.Close()
 
-	if err != nil {
+	for stream.Next() {
+		chunk := stream.Current()
+		if len(chunk.Choices) == 0 {
+			continue
+		}
+		fmt.Print(chunk.Choices[0].Delta.Content)
+	}
+	fmt.Println()
+
+	if err := stream.Err(); err != nil {
 		return fmt.Errorf("error generating answer: %v", err)
 	}
 
-	// Display the generated answer
-	if openAIResponse := response.AsOpenAIChatCompletion(); openAIResponse.Choices != nil {
-		if len(openAIResponse.Choices) > 0 {
-			message := openAIResponse.Choices[0].Message
-			if assistantMessage := message.AsAssistant(); assistantMessage.Content.OfString != "" {
-				fmt.Printf("\nEnhanced Answer:\n%s\n", assistantMessage.Content.OfString)
-			}
+	return nil
+}
+
+// sampleText is the built-in demo content, used when docPathEnvVar isn't set.
+const sampleText = `Artificial Intelligence (AI) is a branch of computer science that aims to create intelligent machines that work and react like humans.
+
+Machine Learning is a subset of AI that enables computers to learn and improve from experience without being explicitly programmed.
+
+Deep Learning is a subset of machine learning that uses neural networks with multiple layers to model and understand complex patterns in data.
+
+Natural Language Processing (NLP) is a field of AI that focuses on the interaction between computers and human language, enabling machines to understand, interpret, and generate human language.
+
+Computer Vision is another AI field that enables computers to interpret and understand visual information from the world, such as images and videos.
+
+These technologies are transforming industries like healthcare, finance, transportation, and entertainment by automating tasks, improving decision-making, and creating new capabilities.`
+
+// llamaEmbedder adapts the LlamaStack embeddings API to rerank.Embedder.
+type llamaEmbedder struct {
+	client *llamastackclient.Client
+}
+
+// Embed implements rerank.Embedder.
+func (e *llamaEmbedder) Embed(ctx context.Context, text string) ([]float64, error) {
+	resp, err := e.client.Embeddings.New(ctx, llamastackclient.EmbeddingNewParams{
+		Input: llamastackclient.EmbeddingNewParamsInputUnion{
+			OfString: llamastackclient.String(text),
+		},
+		Model: "all-MiniLM-L6-v2",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching embedding: %v", err)
+	}
+	if len(resp.Data) == 0 {
+		return nil, fmt.Errorf("error fetching embedding: no data returned")
+	}
+	return resp.Data[0].Embedding.AsFloatArray(), nil
+}
+
+// searchResultText concatenates the text of every content item in a
+// vector store search result into a single string, since a result can
+// carry more than one chunk of matched content.
+func searchResultText(contents []llamastackclient.VectorStoreSearchResponseDataContent) string {
+	var b strings.Builder
+	for i, c := range contents {
+		if i > 0 {
+			b.WriteString("\n")
 		}
+		b.WriteString(c.Text)
 	}
+	return b.String()
+}
 
-	return nil
+// mimeTypeFor returns a best-effort content type for the Files upload
+// based on the source document's extension.
+func mimeTypeFor(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".pdf":
+		return "application/pdf"
+	case ".docx":
+		return "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	case ".html", ".htm":
+		return "text/html"
+	default:
+		return "text/plain"
+	}
 }
@@ -0,0 +1,89 @@
+package rerank
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	llamastackclient "github.com/llamastack/llama-stack-client-go"
+)
+
+// CrossEncoderReranker rescores (query, chunk) pairs with a dedicated
+// reranking model (e.g. bge-reranker) served behind LlamaStack's
+// chat/completions endpoint, prompting the model to emit a single
+// relevance score per pair.
+type CrossEncoderReranker struct {
+	client *llamastackclient.Client
+	model  string
+}
+
+// NewCrossEncoderReranker returns a CrossEncoderReranker that scores
+// pairs using model via client.
+func NewCrossEncoderReranker(client *llamastackclient.Client, model string) *CrossEncoderReranker {
+	return &CrossEncoderReranker{client: client, model: model}
+}
+
+// Rerank implements Reranker.
+func (r *CrossEncoderReranker) Rerank(ctx context.Context, query string, candidates []Result, topN int) ([]Result, error) {
+	if topN <= 0 || topN > len(candidates) {
+		topN = len(candidates)
+	}
+
+	scored := make([]Result, len(candidates))
+	for i, cand := range candidates {
+		score, err := r.score(ctx, query, cand.Content)
+		if err != nil {
+			return nil, fmt.Errorf("rerank: score candidate %s: %v", cand.ID, err)
+		}
+		cand.Score = score
+		scored[i] = cand
+	}
+
+	sort.SliceStable(scored, func(i, j int) bool {
+		return scored[i].Score > scored[j].Score
+	})
+
+	return scored[:topN], nil
+}
+
+// score asks the reranking model for a single relevance score between 0
+// and 1 for the (query, chunk) pair.
+func (r *CrossEncoderReranker) score(ctx context.Context, query, chunk string) (float64, error) {
+	prompt := fmt.Sprintf(
+		"Rate how relevant the passage is to the query on a scale from 0.0 (irrelevant) to 1.0 (highly relevant). "+
+			"Respond with only the number.\n\nQuery: %s\n\nPassage: %s",
+		query, chunk,
+	)
+
+	response, err := r.client.Chat.Completions.New(ctx, llamastackclient.ChatCompletionNewParams{
+		Messages: []llamastackclient.ChatCompletionNewParamsMessageUnion{
+			{
+				OfUser: &llamastackclient.ChatCompletionNewParamsMessageUser{
+					Content: llamastackclient.ChatCompletionNewParamsMessageUserContentUnion{
+						OfString: llamastackclient.String(prompt),
+					},
+				},
+			},
+		},
+		Model:     r.model,
+		MaxTokens: llamastackclient.Int(8),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	openAIResponse := response.AsOpenAIChatCompletion()
+	if len(openAIResponse.Choices) == 0 {
+		return 0, fmt.Errorf("no response from reranking model")
+	}
+
+	text := strings.TrimSpace(openAIResponse.Choices[0].Message.AsAssistant().Content.OfString)
+	score, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse score %q: %v", text, err)
+	}
+
+	return score, nil
+}
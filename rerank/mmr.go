@@ -0,0 +1,76 @@
+package rerank
+
+import (
+	"context"
+	"fmt"
+)
+
+// MMRReranker diversifies a candidate pool using Maximal Marginal
+// Relevance: it iteratively picks the candidate maximizing
+//
+//	lambda * sim(query, candidate) - (1-lambda) * max(sim(candidate, selected))
+//
+// until topN candidates are selected, trading off relevance against
+// redundancy with already-selected results.
+type MMRReranker struct {
+	embedder Embedder
+	lambda   float64
+}
+
+// NewMMRReranker returns an MMRReranker using embedder to embed the
+// query (candidates are expected to already carry embeddings). lambda
+// controls the relevance/diversity trade-off: 1.0 is pure relevance,
+// 0.0 is pure diversity. It's clamped to [0, 1].
+func NewMMRReranker(embedder Embedder, lambda float64) *MMRReranker {
+	if lambda < 0 {
+		lambda = 0
+	}
+	if lambda > 1 {
+		lambda = 1
+	}
+	return &MMRReranker{embedder: embedder, lambda: lambda}
+}
+
+// Rerank implements Reranker.
+func (r *MMRReranker) Rerank(ctx context.Context, query string, candidates []Result, topN int) ([]Result, error) {
+	if topN <= 0 || topN > len(candidates) {
+		topN = len(candidates)
+	}
+
+	queryEmbedding, err := r.embedder.Embed(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("rerank: embed query: %v", err)
+	}
+
+	remaining := append([]Result(nil), candidates...)
+	var selected []Result
+
+	for len(selected) < topN && len(remaining) > 0 {
+		bestIdx := -1
+		bestScore := 0.0
+
+		for i, cand := range remaining {
+			relevance := cosineSimilarity(queryEmbedding, cand.Embedding)
+
+			redundancy := 0.0
+			for _, sel := range selected {
+				if sim := cosineSimilarity(cand.Embedding, sel.Embedding); sim > redundancy {
+					redundancy = sim
+				}
+			}
+
+			mmrScore := r.lambda*relevance - (1-r.lambda)*redundancy
+			if bestIdx == -1 || mmrScore > bestScore {
+				bestIdx = i
+				bestScore = mmrScore
+			}
+		}
+
+		chosen := remaining[bestIdx]
+		chosen.Score = bestScore
+		selected = append(selected, chosen)
+		remaining = append(remaining[:bestIdx], remaining[bestIdx+1:]...)
+	}
+
+	return selected, nil
+}
@@ -0,0 +1,75 @@
+package rerank
+
+import (
+	"context"
+	"testing"
+)
+
+// fakeEmbedder returns a fixed embedding for the query, regardless of
+// text, so tests can control similarity purely through candidate
+// embeddings.
+type fakeEmbedder struct {
+	embedding []float64
+}
+
+func (f *fakeEmbedder) Embed(ctx context.Context, text string) ([]float64, error) {
+	return f.embedding, nil
+}
+
+func TestMMRReranker_PrefersRelevanceWhenLambdaIsOne(t *testing.T) {
+	embedder := &fakeEmbedder{embedding: []float64{1, 0}}
+	r := NewMMRReranker(embedder, 1.0)
+
+	candidates := []Result{
+		{ID: "a", Embedding: []float64{1, 0}},   // identical to query
+		{ID: "b", Embedding: []float64{0.9, 0.1}}, // very similar
+		{ID: "c", Embedding: []float64{0, 1}},   // orthogonal
+	}
+
+	selected, err := r.Rerank(context.Background(), "q", candidates, 2)
+	if err != nil {
+		t.Fatalf("Rerank: %v", err)
+	}
+	if len(selected) != 2 {
+		t.Fatalf("got %d results, want 2", len(selected))
+	}
+	if selected[0].ID != "a" {
+		t.Errorf("selected[0].ID = %q, want %q", selected[0].ID, "a")
+	}
+}
+
+func TestMMRReranker_DiversifiesWhenLambdaIsZero(t *testing.T) {
+	embedder := &fakeEmbedder{embedding: []float64{1, 0}}
+	r := NewMMRReranker(embedder, 0.0)
+
+	candidates := []Result{
+		{ID: "a", Embedding: []float64{1, 0}},
+		{ID: "b", Embedding: []float64{1, 0}}, // duplicate of a
+		{ID: "c", Embedding: []float64{0, 1}}, // diverse
+	}
+
+	selected, err := r.Rerank(context.Background(), "q", candidates, 2)
+	if err != nil {
+		t.Fatalf("Rerank: %v", err)
+	}
+	if len(selected) != 2 {
+		t.Fatalf("got %d results, want 2", len(selected))
+	}
+
+	ids := map[string]bool{selected[0].ID: true, selected[1].ID: true}
+	if !ids["c"] {
+		t.Errorf("expected diverse candidate %q to be selected, got %v", "c", ids)
+	}
+}
+
+func TestMMRReranker_ClampsLambda(t *testing.T) {
+	r := NewMMRReranker(&fakeEmbedder{}, 5.0)
+	if r.lambda != 1.0 {
+		t.Errorf("lambda = %v, want 1.0", r.lambda)
+	}
+
+	r = NewMMRReranker(&fakeEmbedder{}, -5.0)
+	if r.lambda != 0.0 {
+		t.Errorf("lambda = %v, want 0.0", r.lambda)
+	}
+}
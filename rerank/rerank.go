@@ -0,0 +1,36 @@
+// Package rerank rescores and reorders chunks returned by an initial
+// vector-store search, as a quality step between retrieval and
+// generation.
+package rerank
+
+import "context"
+
+// Result is one retrieved chunk being reranked. It mirrors the fields
+// of a vector store search result that reranking needs.
+type Result struct {
+	// ID identifies the chunk (e.g. the underlying file or chunk ID).
+	ID string
+	// Content is the chunk's text.
+	Content string
+	// Score is the score assigned by the initial retrieval stage. The
+	// Results a Reranker returns carry the reranker's own score instead.
+	Score float64
+	// Embedding is the chunk's embedding vector, required by rerankers
+	// (such as MMR) that operate on vector similarity rather than text.
+	// It may be nil for rerankers that don't need it.
+	Embedding []float64
+}
+
+// Reranker reorders and rescores a candidate pool of results for query,
+// returning the top topN.
+type Reranker interface {
+	Rerank(ctx context.Context, query string, candidates []Result, topN int) ([]Result, error)
+}
+
+// Embedder produces an embedding vector for a piece of text. It's
+// implemented by a thin wrapper around the LlamaStack embeddings API,
+// needed so MMRReranker can compare the query against candidate
+// embeddings in the same vector space.
+type Embedder interface {
+	Embed(ctx context.Context, text string) ([]float64, error)
+}
@@ -0,0 +1,25 @@
+package rerank
+
+import "math"
+
+// cosineSimilarity returns the cosine similarity between a and b. It
+// returns 0 if either vector has zero magnitude or the vectors have
+// mismatched lengths.
+func cosineSimilarity(a, b []float64) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
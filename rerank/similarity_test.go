@@ -0,0 +1,26 @@
+package rerank
+
+import "testing"
+
+func TestCosineSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []float64
+		want float64
+	}{
+		{"identical vectors", []float64{1, 0}, []float64{1, 0}, 1},
+		{"orthogonal vectors", []float64{1, 0}, []float64{0, 1}, 0},
+		{"opposite vectors", []float64{1, 0}, []float64{-1, 0}, -1},
+		{"mismatched lengths", []float64{1, 0}, []float64{1, 0, 0}, 0},
+		{"zero vector", []float64{0, 0}, []float64{1, 1}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := cosineSimilarity(tt.a, tt.b)
+			if got != tt.want {
+				t.Errorf("cosineSimilarity(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
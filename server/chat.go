@@ -0,0 +1,227 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	llamastackclient "github.com/llamastack/llama-stack-client-go"
+)
+
+const ragCandidatePool = 5
+
+// handleChatCompletions implements POST /v1/chat/completions. It
+// retrieves context for the latest user message from the configured
+// vector store, injects it as a system message, and forwards the
+// conversation to LlamaStack, replying in the OpenAI wire format
+// (including SSE streaming when "stream": true is set).
+func (s *Server) handleChatCompletions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req chatCompletionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+
+	userQuery := lastUserMessage(req.Messages)
+	contextText, err := s.retrieveContext(ctx, userQuery)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("retrieval failed: %v", err), http.StatusBadGateway)
+		return
+	}
+
+	upstreamMessages := buildUpstreamMessages(req.Messages, contextText)
+
+	if req.Stream {
+		s.streamChatCompletions(w, r, req.Model, upstreamMessages)
+		return
+	}
+
+	response, err := s.client.Chat.Completions.New(ctx, llamastackclient.ChatCompletionNewParams{
+		Messages:  upstreamMessages,
+		Model:     req.Model,
+		MaxTokens: llamastackclient.Int(300),
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("generation failed: %v", err), http.StatusBadGateway)
+		return
+	}
+
+	openAIResponse := response.AsOpenAIChatCompletion()
+	content := ""
+	if len(openAIResponse.Choices) > 0 {
+		content = openAIResponse.Choices[0].Message.AsAssistant().Content.OfString
+	}
+
+	finishReason := "stop"
+	writeJSON(w, chatCompletionResponse{
+		Object: "chat.completion",
+		Model:  req.Model,
+		Choices: []choice{
+			{
+				Index:        0,
+				Message:      &chatMessage{Role: "assistant", Content: content},
+				FinishReason: &finishReason,
+			},
+		},
+	})
+}
+
+// streamChatCompletions writes an SSE response, one "data: {...}" frame
+// per token, ending with "data: [DONE]".
+func (s *Server) streamChatCompletions(w http.ResponseWriter, r *http.Request, model string, messages []llamastackclient.ChatCompletionNewParamsMessageUnion) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	stream := s.client.Chat.Completions.NewStreaming(r.Context(), llamastackclient.ChatCompletionNewParams{
+		Messages:  messages,
+		Model:     model,
+		MaxTokens: llamastackclient.Int(300),
+	})
+	defer stream.Close()
+
+	for stream.Next() {
+		chunk := stream.Current()
+		if len(chunk.Choices) == 0 {
+			continue
+		}
+
+		frame := chatCompletionChunk{
+			Object: "chat.completion.chunk",
+			Model:  model,
+			Choices: []choice{
+				{
+					Index: 0,
+					Delta: &chatMessage{Content: chunk.Choices[0].Delta.Content},
+				},
+			},
+		}
+
+		payload, _ := json.Marshal(frame)
+		fmt.Fprintf(w, "data: %s\n\n", payload)
+		flusher.Flush()
+	}
+
+	fmt.Fprint(w, "data: [DONE]\n\n")
+	flusher.Flush()
+}
+
+// retrieveContext searches the configured vector store for query and
+// renders the top results as a context block. If the server has no
+// vector store configured, or query is empty, it returns "" so the
+// request is forwarded without injected context.
+func (s *Server) retrieveContext(ctx context.Context, query string) (string, error) {
+	if s.vectorStoreID == "" || query == "" {
+		return "", nil
+	}
+
+	results, err := s.client.VectorStores.Search(ctx, s.vectorStoreID, llamastackclient.VectorStoreSearchParams{
+		Query: llamastackclient.VectorStoreSearchParamsQueryUnion{
+			OfString: llamastackclient.String(query),
+		},
+		MaxNumResults: llamastackclient.Int(ragCandidatePool),
+	})
+	if err != nil {
+		return "", fmt.Errorf("search vector store: %v", err)
+	}
+
+	var b strings.Builder
+	for i, result := range results.Data {
+		fmt.Fprintf(&b, "%d. %s\n", i+1, searchResultText(result.Content))
+	}
+	return b.String(), nil
+}
+
+// searchResultText concatenates the text of every content item in a
+// vector store search result into a single string, since a result can
+// carry more than one chunk of matched content.
+func searchResultText(contents []llamastackclient.VectorStoreSearchResponseDataContent) string {
+	var b strings.Builder
+	for i, c := range contents {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(c.Text)
+	}
+	return b.String()
+}
+
+// lastUserMessage returns the content of the last "user" message, or ""
+// if there isn't one.
+func lastUserMessage(messages []chatMessage) string {
+	for i := len(messages) - 1; i >= 0; i-- {
+		if messages[i].Role == "user" {
+			return messages[i].Content
+		}
+	}
+	return ""
+}
+
+// buildUpstreamMessages converts the OpenAI-shaped request messages
+// into the SDK's message types, injecting contextText as a leading
+// system message when non-empty.
+func buildUpstreamMessages(messages []chatMessage, contextText string) []llamastackclient.ChatCompletionNewParamsMessageUnion {
+	var out []llamastackclient.ChatCompletionNewParamsMessageUnion
+
+	if contextText != "" {
+		out = append(out, llamastackclient.ChatCompletionNewParamsMessageUnion{
+			OfSystem: &llamastackclient.ChatCompletionNewParamsMessageSystem{
+				Content: llamastackclient.ChatCompletionNewParamsMessageSystemContentUnion{
+					OfString: llamastackclient.String("Use the following retrieved context to answer the user:\n\n" + contextText),
+				},
+			},
+		})
+	}
+
+	for _, m := range messages {
+		switch strings.ToLower(m.Role) {
+		case "system":
+			out = append(out, llamastackclient.ChatCompletionNewParamsMessageUnion{
+				OfSystem: &llamastackclient.ChatCompletionNewParamsMessageSystem{
+					Content: llamastackclient.ChatCompletionNewParamsMessageSystemContentUnion{
+						OfString: llamastackclient.String(m.Content),
+					},
+				},
+			})
+		case "assistant":
+			out = append(out, llamastackclient.ChatCompletionNewParamsMessageUnion{
+				OfAssistant: &llamastackclient.ChatCompletionNewParamsMessageAssistant{
+					Content: llamastackclient.ChatCompletionNewParamsMessageAssistantContentUnion{
+						OfString: llamastackclient.String(m.Content),
+					},
+				},
+			})
+		default:
+			out = append(out, llamastackclient.ChatCompletionNewParamsMessageUnion{
+				OfUser: &llamastackclient.ChatCompletionNewParamsMessageUser{
+					Content: llamastackclient.ChatCompletionNewParamsMessageUserContentUnion{
+						OfString: llamastackclient.String(m.Content),
+					},
+				},
+			})
+		}
+	}
+
+	return out
+}
+
+// writeJSON writes v as a JSON response body.
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
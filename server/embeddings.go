@@ -0,0 +1,51 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	llamastackclient "github.com/llamastack/llama-stack-client-go"
+)
+
+// handleEmbeddings implements POST /v1/embeddings, forwarding each
+// input string to LlamaStack's embeddings endpoint and replying in the
+// OpenAI wire format.
+func (s *Server) handleEmbeddings(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req embeddingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	data := make([]embeddingData, len(req.Input))
+	for i, input := range req.Input {
+		resp, err := s.client.Embeddings.New(r.Context(), llamastackclient.EmbeddingNewParams{
+			Input: llamastackclient.EmbeddingNewParamsInputUnion{
+				OfString: llamastackclient.String(input),
+			},
+			Model: req.Model,
+		})
+		if err != nil {
+			http.Error(w, fmt.Sprintf("embedding failed: %v", err), http.StatusBadGateway)
+			return
+		}
+		if len(resp.Data) == 0 {
+			http.Error(w, "embedding failed: no data returned", http.StatusBadGateway)
+			return
+		}
+
+		data[i] = embeddingData{Index: i, Object: "embedding", Embedding: resp.Data[0].Embedding.AsFloatArray()}
+	}
+
+	writeJSON(w, embeddingsResponse{
+		Object: "list",
+		Model:  req.Model,
+		Data:   data,
+	})
+}
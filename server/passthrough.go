@@ -0,0 +1,15 @@
+package server
+
+import "net/http"
+
+// handleFiles passes /v1/files requests straight through to LlamaStack,
+// so existing OpenAI-SDK file-upload code keeps working unmodified.
+func (s *Server) handleFiles(w http.ResponseWriter, r *http.Request) {
+	s.proxy.ServeHTTP(w, r)
+}
+
+// handleVectorStores passes /v1/vector_stores/* requests straight
+// through to LlamaStack.
+func (s *Server) handleVectorStores(w http.ResponseWriter, r *http.Request) {
+	s.proxy.ServeHTTP(w, r)
+}
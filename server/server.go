@@ -0,0 +1,63 @@
+// Package server exposes the RAG pipeline behind an OpenAI-compatible
+// HTTP API, so existing OpenAI-SDK clients (Python, JS, LangChain) can
+// point their base_url at it and transparently get retrieval-augmented
+// answers from a LlamaStack-backed vector store.
+package server
+
+import (
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+
+	llamastackclient "github.com/llamastack/llama-stack-client-go"
+)
+
+// Server serves the OpenAI-compatible wire format over HTTP, performing
+// retrieval against a configured vector store on every chat completion
+// request before forwarding to LlamaStack.
+type Server struct {
+	client        *llamastackclient.Client
+	vectorStoreID string
+	mux           *http.ServeMux
+	proxy         *httputil.ReverseProxy
+}
+
+// New returns a Server that retrieves from vectorStoreID and forwards
+// generation requests through client. llamaStackURL is the LlamaStack
+// server's base URL, used to passthrough /v1/files and
+// /v1/vector_stores/* requests that client doesn't wrap.
+func New(client *llamastackclient.Client, vectorStoreID, llamaStackURL string) (*Server, error) {
+	target, err := url.Parse(llamaStackURL)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		client:        client,
+		vectorStoreID: vectorStoreID,
+		mux:           http.NewServeMux(),
+		proxy:         httputil.NewSingleHostReverseProxy(target),
+	}
+	s.routes()
+	return s, nil
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) routes() {
+	s.mux.HandleFunc("/v1/chat/completions", s.handleChatCompletions)
+	s.mux.HandleFunc("/v1/embeddings", s.handleEmbeddings)
+
+	// Register both the bare collection path and the "/"-suffixed
+	// subtree: ServeMux only treats a pattern ending in "/" as a prefix
+	// match, so without the bare entry too, requests to the exact
+	// collection path (no trailing slash) get redirected instead of
+	// proxied — and most clients won't replay a POST through a redirect.
+	s.mux.HandleFunc("/v1/files", s.handleFiles)
+	s.mux.HandleFunc("/v1/files/", s.handleFiles)
+	s.mux.HandleFunc("/v1/vector_stores", s.handleVectorStores)
+	s.mux.HandleFunc("/v1/vector_stores/", s.handleVectorStores)
+}
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	llamastackclient "github.com/llamastack/llama-stack-client-go"
+	"github.com/llamastack/llama-stack-client-go/option"
+)
+
+// TestRoutes_PassthroughSubtreesAndBareCollections guards against the
+// ServeMux trailing-slash redirect trap: a bare "/v1/files" or
+// "/v1/vector_stores" request must be proxied (not 301-redirected), and
+// a sub-path like "/v1/files/abc123" must be routed at all (not 404).
+func TestRoutes_PassthroughSubtreesAndBareCollections(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer upstream.Close()
+
+	client := llamastackclient.NewClient(option.WithBaseURL(upstream.URL))
+	srv, err := New(&client, "", upstream.URL)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	paths := []string{
+		"/v1/files",
+		"/v1/files/abc123",
+		"/v1/vector_stores",
+		"/v1/vector_stores/vs_123",
+	}
+
+	for _, path := range paths {
+		t.Run(path, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			rec := httptest.NewRecorder()
+
+			srv.ServeHTTP(rec, req)
+
+			if rec.Code == http.StatusNotFound {
+				t.Errorf("%s: got 404, want it to be routed to the passthrough proxy", path)
+			}
+			if rec.Code == http.StatusMovedPermanently || rec.Code == http.StatusPermanentRedirect {
+				t.Errorf("%s: got redirect %d, want the request proxied without a redirect", path, rec.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package server
+
+// chatCompletionRequest is the subset of the OpenAI chat/completions
+// request body this server understands.
+type chatCompletionRequest struct {
+	Model    string        `json:"model"`
+	Messages []chatMessage `json:"messages"`
+	Stream   bool          `json:"stream"`
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// chatCompletionResponse mirrors OpenAI's non-streaming chat completion
+// response shape.
+type chatCompletionResponse struct {
+	ID      string          `json:"id"`
+	Object  string          `json:"object"`
+	Model   string          `json:"model"`
+	Choices []choice        `json:"choices"`
+	Usage   completionUsage `json:"usage"`
+}
+
+type choice struct {
+	Index        int          `json:"index"`
+	Message      *chatMessage `json:"message,omitempty"`
+	Delta        *chatMessage `json:"delta,omitempty"`
+	FinishReason *string      `json:"finish_reason"`
+}
+
+type completionUsage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+	TotalTokens      int `json:"total_tokens"`
+}
+
+// chatCompletionChunk mirrors an OpenAI SSE streaming chunk.
+type chatCompletionChunk struct {
+	ID      string   `json:"id"`
+	Object  string   `json:"object"`
+	Model   string   `json:"model"`
+	Choices []choice `json:"choices"`
+}
+
+// embeddingsRequest mirrors the OpenAI /v1/embeddings request body.
+type embeddingsRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
+
+// embeddingsResponse mirrors the OpenAI /v1/embeddings response body.
+type embeddingsResponse struct {
+	Object string          `json:"object"`
+	Model  string          `json:"model"`
+	Data   []embeddingData `json:"data"`
+	Usage  completionUsage `json:"usage"`
+}
+
+type embeddingData struct {
+	Index     int       `json:"index"`
+	Object    string    `json:"object"`
+	Embedding []float64 `json:"embedding"`
+}
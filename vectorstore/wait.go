@@ -0,0 +1,140 @@
+// Package vectorstore provides helpers for working with a LlamaStack
+// vector store beyond the raw client calls, such as waiting for
+// uploaded files to finish processing before they're queried.
+package vectorstore
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	llamastackclient "github.com/llamastack/llama-stack-client-go"
+)
+
+// WaitOptions configures the polling backoff used by WaitForFileReady
+// and WaitForFilesReady.
+type WaitOptions struct {
+	// InitialInterval is the delay between the first poll, which happens
+	// immediately, and the second; it doubles after each later poll.
+	// Defaults to 500ms if zero.
+	InitialInterval time.Duration
+	// MaxInterval caps the exponential backoff between polls. Defaults
+	// to 10s if zero.
+	MaxInterval time.Duration
+	// Timeout is the overall time budget for reaching a terminal state.
+	// Defaults to 2 minutes if zero.
+	Timeout time.Duration
+}
+
+func (o WaitOptions) withDefaults() WaitOptions {
+	if o.InitialInterval <= 0 {
+		o.InitialInterval = 500 * time.Millisecond
+	}
+	if o.MaxInterval <= 0 {
+		o.MaxInterval = 10 * time.Second
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = 2 * time.Minute
+	}
+	return o
+}
+
+// WaitForFileReady polls a vector store file's processing status with
+// exponential backoff until it reaches a terminal state ("completed" or
+// "failed"), the context is cancelled, or opts.Timeout elapses. It
+// returns the final file record, or an error if the file failed to
+// process or the wait timed out.
+func WaitForFileReady(ctx context.Context, client *llamastackclient.Client, vectorStoreID, fileID string, opts WaitOptions) (*llamastackclient.VectorStoreFile, error) {
+	return pollUntilReady(ctx, fileID, opts, func(ctx context.Context) (*llamastackclient.VectorStoreFile, error) {
+		return client.VectorStores.Files.Get(ctx, fileID, llamastackclient.VectorStoreFileGetParams{VectorStoreID: vectorStoreID})
+	})
+}
+
+// pollUntilReady holds the polling/backoff logic shared by
+// WaitForFileReady, decoupled from the concrete client so it can be
+// exercised with a fake getFile in tests.
+func pollUntilReady(ctx context.Context, fileID string, opts WaitOptions, getFile func(ctx context.Context) (*llamastackclient.VectorStoreFile, error)) (*llamastackclient.VectorStoreFile, error) {
+	opts = opts.withDefaults()
+
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	interval := opts.InitialInterval
+	for {
+		file, err := getFile(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("vectorstore: get file %s: %v", fileID, err)
+		}
+
+		switch file.Status {
+		case "completed":
+			return file, nil
+		case "failed":
+			return file, fmt.Errorf("vectorstore: file %s failed to process: %s", fileID, file.LastError.Message)
+		}
+
+		select {
+		case <-ctx.Done():
+			return file, fmt.Errorf("vectorstore: timed out waiting for file %s to become ready: %v", fileID, ctx.Err())
+		case <-time.After(interval):
+		}
+
+		interval *= 2
+		if interval > opts.MaxInterval {
+			interval = opts.MaxInterval
+		}
+	}
+}
+
+// FileResult is the outcome of waiting for a single file within
+// WaitForFilesReady.
+type FileResult struct {
+	FileID string
+	File   *llamastackclient.VectorStoreFile
+	Err    error
+}
+
+// Summary aggregates the outcomes of a batch WaitForFilesReady call.
+type Summary struct {
+	Results   []FileResult
+	Completed int
+	Failed    int
+}
+
+// WaitForFilesReady waits for many files concurrently, using a worker
+// pool bounded by maxConcurrency (clamped to at least 1), and returns a
+// Summary once every file has reached a terminal state or the wait for
+// it has errored out.
+func WaitForFilesReady(ctx context.Context, client *llamastackclient.Client, vectorStoreID string, fileIDs []string, maxConcurrency int, opts WaitOptions) Summary {
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+
+	results := make([]FileResult, len(fileIDs))
+	sem := make(chan struct{}, maxConcurrency)
+	var wg sync.WaitGroup
+
+	for i, fileID := range fileIDs {
+		wg.Add(1)
+		go func(i int, fileID string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			file, err := WaitForFileReady(ctx, client, vectorStoreID, fileID, opts)
+			results[i] = FileResult{FileID: fileID, File: file, Err: err}
+		}(i, fileID)
+	}
+	wg.Wait()
+
+	summary := Summary{Results: results}
+	for _, r := range results {
+		if r.Err == nil {
+			summary.Completed++
+		} else {
+			summary.Failed++
+		}
+	}
+	return summary
+}
@@ -0,0 +1,86 @@
+package vectorstore
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	llamastackclient "github.com/llamastack/llama-stack-client-go"
+)
+
+func TestPollUntilReady_CompletesAfterProcessing(t *testing.T) {
+	calls := 0
+	getFile := func(ctx context.Context) (*llamastackclient.VectorStoreFile, error) {
+		calls++
+		status := llamastackclient.VectorStoreFileStatusInProgress
+		if calls >= 3 {
+			status = llamastackclient.VectorStoreFileStatusCompleted
+		}
+		return &llamastackclient.VectorStoreFile{Status: status}, nil
+	}
+
+	opts := WaitOptions{InitialInterval: time.Millisecond, MaxInterval: 2 * time.Millisecond, Timeout: time.Second}
+	file, err := pollUntilReady(context.Background(), "file-1", opts, getFile)
+	if err != nil {
+		t.Fatalf("pollUntilReady: %v", err)
+	}
+	if file.Status != "completed" {
+		t.Errorf("file.Status = %q, want %q", file.Status, "completed")
+	}
+	if calls < 3 {
+		t.Errorf("called getFile %d times, want at least 3", calls)
+	}
+}
+
+func TestPollUntilReady_ReturnsErrorOnFailedStatus(t *testing.T) {
+	getFile := func(ctx context.Context) (*llamastackclient.VectorStoreFile, error) {
+		return &llamastackclient.VectorStoreFile{Status: "failed"}, nil
+	}
+
+	opts := WaitOptions{InitialInterval: time.Millisecond, Timeout: time.Second}
+	_, err := pollUntilReady(context.Background(), "file-1", opts, getFile)
+	if err == nil {
+		t.Fatal("expected error for failed status, got nil")
+	}
+}
+
+func TestPollUntilReady_PropagatesGetFileError(t *testing.T) {
+	wantErr := errors.New("network error")
+	getFile := func(ctx context.Context) (*llamastackclient.VectorStoreFile, error) {
+		return nil, wantErr
+	}
+
+	opts := WaitOptions{InitialInterval: time.Millisecond, Timeout: time.Second}
+	_, err := pollUntilReady(context.Background(), "file-1", opts, getFile)
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestPollUntilReady_RespectsContextCancellation(t *testing.T) {
+	getFile := func(ctx context.Context) (*llamastackclient.VectorStoreFile, error) {
+		return &llamastackclient.VectorStoreFile{Status: "in_progress"}, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	opts := WaitOptions{InitialInterval: time.Millisecond, Timeout: time.Second}
+	_, err := pollUntilReady(ctx, "file-1", opts, getFile)
+	if err == nil {
+		t.Fatal("expected error from cancelled context, got nil")
+	}
+}
+
+func TestPollUntilReady_TimesOut(t *testing.T) {
+	getFile := func(ctx context.Context) (*llamastackclient.VectorStoreFile, error) {
+		return &llamastackclient.VectorStoreFile{Status: "in_progress"}, nil
+	}
+
+	opts := WaitOptions{InitialInterval: time.Millisecond, MaxInterval: time.Millisecond, Timeout: 5 * time.Millisecond}
+	_, err := pollUntilReady(context.Background(), "file-1", opts, getFile)
+	if err == nil {
+		t.Fatal("expected timeout error, got nil")
+	}
+}